@@ -0,0 +1,11 @@
+-- +migrate Up
+
+CREATE TABLE block_cursors
+(
+    chain_id VARCHAR(50) PRIMARY KEY,
+    block    BIGINT NOT NULL
+);
+
+-- +migrate Down
+
+DROP TABLE block_cursors;
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE deposits
+    ADD COLUMN pending_confirmation BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX deposits_pending_confirmation_idx ON deposits (withdrawal_chain_id) WHERE pending_confirmation;
+
+-- +migrate Down
+
+DROP INDEX deposits_pending_confirmation_idx;
+
+ALTER TABLE deposits
+    DROP COLUMN pending_confirmation;
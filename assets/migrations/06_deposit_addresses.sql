@@ -0,0 +1,18 @@
+-- +migrate Up
+
+CREATE TABLE deposit_addresses
+(
+    chain_id             VARCHAR(50)  NOT NULL,
+    address              VARCHAR(100) NOT NULL,
+    destination_chain_id VARCHAR(50)  NOT NULL,
+    destination_address  VARCHAR(100) NOT NULL,
+    distributed          BOOLEAN      NOT NULL DEFAULT false,
+
+    PRIMARY KEY (chain_id, address)
+);
+
+CREATE INDEX deposit_addresses_not_distributed_idx ON deposit_addresses (chain_id) WHERE NOT distributed;
+
+-- +migrate Down
+
+DROP TABLE deposit_addresses;
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+ALTER TABLE deposits
+    ADD COLUMN withdrawal_tx_nonce BIGINT;
+
+-- +migrate Down
+
+ALTER TABLE deposits
+    DROP COLUMN withdrawal_tx_nonce;
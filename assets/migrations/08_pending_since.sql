@@ -0,0 +1,12 @@
+-- +migrate Up
+
+ALTER TABLE deposits
+    ADD COLUMN pending_since TIMESTAMPTZ;
+
+-- the broadcast time of the already pending withdrawals is unknown, the stuck threshold is counted from now
+UPDATE deposits SET pending_since = now() WHERE pending_confirmation;
+
+-- +migrate Down
+
+ALTER TABLE deposits
+    DROP COLUMN pending_since;
@@ -0,0 +1,35 @@
+package parse
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/tron"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var parseAddressTronCmd = &cobra.Command{
+	Use:   "address-tron [x-cord] [y-cord]",
+	Short: "Parse TRON address from the given point",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		xCord, ok := new(big.Int).SetString(args[0], 10)
+		if !ok {
+			return errors.New("failed to parse x-cord")
+		}
+
+		yCord, ok := new(big.Int).SetString(args[1], 10)
+		if !ok {
+			return errors.New("failed to parse y-cord")
+		}
+
+		// TRON account address is the Ethereum one with the 0x41 prefix, base58check-encoded
+		pubkey := &ecdsa.PublicKey{Curve: crypto.S256(), X: xCord, Y: yCord}
+		fmt.Println("TRON address:", tron.FromEvmAddress(crypto.PubkeyToAddress(*pubkey)))
+
+		return nil
+	},
+}
@@ -13,8 +13,9 @@ import (
 )
 
 var (
-	network = string(utxotypes.DefaultNetwork)
-	chain   = string(utxotypes.DefaultChain)
+	network     = string(utxotypes.DefaultNetwork)
+	chain       = string(utxotypes.DefaultChain)
+	addressType = string(utxotypes.DefaultAddressType)
 )
 
 func init() {
@@ -22,8 +23,9 @@ func init() {
 }
 
 func registerParseAddressUtxoFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVar(&network, "network", "mainnet", "Network type (mainnet/testnet3/testnet4)")
-	cmd.Flags().StringVar(&chain, "chain", "btc", "Chain type (btc/bch)")
+	cmd.Flags().StringVar(&network, "network", "mainnet", "Network type (mainnet/testnet3/testnet4/regtest/signet)")
+	cmd.Flags().StringVar(&chain, "chain", "btc", "Chain type (btc/bch/ltc/doge)")
+	cmd.Flags().StringVar(&addressType, "address-type", "p2pkh", "Address type (p2pkh/p2wpkh), p2wpkh is supported only for btc and ltc")
 }
 
 var parseAddressUtxoCmd = &cobra.Command{
@@ -40,18 +42,24 @@ var parseAddressUtxoCmd = &cobra.Command{
 			return errors.New("failed to parse y-cord")
 		}
 
-		var net, ch = utxotypes.Network(network), utxotypes.Chain(chain)
+		var net, ch, addrType = utxotypes.Network(network), utxotypes.Chain(chain), utxotypes.AddressType(addressType)
 		if err := net.Validate(); err != nil {
 			return errors.Wrap(err, "invalid network type")
 		}
 		if err := ch.Validate(); err != nil {
 			return errors.Wrap(err, "invalid chain type")
 		}
+		if err := addrType.Validate(); err != nil {
+			return errors.Wrap(err, "invalid address type")
+		}
+		if !ch.SegwitSupported() && addrType != utxotypes.AddressTypeP2pkh {
+			return errors.New(fmt.Sprintf("address type %s is not supported for %s chain", addrType, ch))
+		}
 
-		hlp := factory.NewUtxoHelper(ch, net)
+		hlp := factory.NewUtxoHelper(ch, net, addrType)
 		pubkey := &ecdsa.PublicKey{Curve: crypto.S256(), X: xCord, Y: yCord}
 
-		fmt.Println("Utxo address:", hlp.P2pkhAddress(pubkey))
+		fmt.Println("Utxo address:", hlp.WalletAddress(pubkey))
 
 		return nil
 	},
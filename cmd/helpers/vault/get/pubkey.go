@@ -4,10 +4,18 @@ import (
 	"fmt"
 
 	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+var pubkeyCurve = string(tss.DefaultCurve)
+
+func init() {
+	pubkeyCmd.Flags().StringVar(&pubkeyCurve, "curve", pubkeyCurve, "Curve of the TSS key: secp256k1 or ed25519")
+}
+
 var pubkeyCmd = &cobra.Command{
 	Use:   "pubkey",
 	Short: "Get the TSS public key from the vault",
@@ -18,6 +26,17 @@ var pubkeyCmd = &cobra.Command{
 		}
 
 		storage := config.SecretsStorage()
+		if tss.Curve(pubkeyCurve) == tss.CurveEd25519 {
+			share, err := storage.GetEddsaTssShare()
+			if err != nil {
+				return errors.Wrap(err, "failed to get TSS EdDSA share from vault")
+			}
+
+			fmt.Println("Ed25519 public key:", hexutil.Encode(tss.Ed25519PubKey(share.EDDSAPub.X(), share.EDDSAPub.Y())))
+
+			return nil
+		}
+
 		share, err := storage.GetTssShare()
 		if err != nil {
 			return errors.Wrap(err, "failed to get TSS share from vault")
@@ -0,0 +1,29 @@
+package set
+
+import (
+	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var relayerKeyCmd = &cobra.Command{
+	Use:  "relayer-key [chain-id] [priv-key]",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		chainId, privKey := args[0], args[1]
+
+		config, err := utils.ConfigFromFlags(cmd)
+		if err != nil {
+			return errors.Wrap(err, "failed to get config from flags")
+		}
+
+		storage := config.SecretsStorage()
+		if err = storage.SaveRelayerKey(chainId, privKey); err != nil {
+			return errors.Wrap(err, "failed to save relayer key to vault")
+		}
+
+		config.Log().Infof("Relayer key for chain %s was successfully saved", chainId)
+
+		return nil
+	},
+}
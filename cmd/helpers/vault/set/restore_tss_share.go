@@ -0,0 +1,35 @@
+package set
+
+import (
+	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var restoreTssShareCmd = &cobra.Command{
+	Use:   "restore-tss-share",
+	Short: "Restores the TSS share replaced by the last key share refresh",
+	Long: "Restores the TSS share replaced by the last key share refresh.\n" +
+		"Should be used if the refresh was committed by a part of the parties only, " +
+		"so all the parties return to the same key share generation.",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := utils.ConfigFromFlags(cmd)
+		if err != nil {
+			return errors.Wrap(err, "failed to get config from flags")
+		}
+
+		storage := config.SecretsStorage()
+		share, err := storage.GetPreviousTssShare()
+		if err != nil {
+			return errors.Wrap(err, "failed to get previous TSS share from vault")
+		}
+		if err = storage.SaveTssShare(share); err != nil {
+			return errors.Wrap(err, "failed to save TSS share to vault")
+		}
+
+		config.Log().Info("previous TSS share was successfully restored")
+
+		return nil
+	},
+}
@@ -16,5 +16,7 @@ func registerSetCommands(cmd *cobra.Command) {
 		cosmosAccountCmd,
 		tssShareCmd,
 		tlsCertCmd,
+		relayerKeyCmd,
+		restoreTssShareCmd,
 	)
 }
@@ -5,11 +5,19 @@ import (
 	"os"
 
 	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	eddsaKeygen "github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+var tssShareCurve = string(tss.DefaultCurve)
+
+func init() {
+	tssShareCmd.Flags().StringVar(&tssShareCurve, "curve", tssShareCurve, "Curve of the TSS share: secp256k1 or ed25519")
+}
+
 var tssShareCmd = &cobra.Command{
 	Use:  "tss-share [path-to-share-json]",
 	Args: cobra.ExactArgs(1),
@@ -21,19 +29,31 @@ var tssShareCmd = &cobra.Command{
 			return errors.Wrap(err, "failed to read TSS share file")
 		}
 
-		var share *keygen.LocalPartySaveData
-		if err := json.Unmarshal(raw, &share); err != nil {
-			return errors.Wrap(err, "failed to unmarshal TSS share")
-		}
-
 		config, err := utils.ConfigFromFlags(cmd)
 		if err != nil {
 			return errors.Wrap(err, "failed to get config from flags")
 		}
 
 		storage := config.SecretsStorage()
-		if err := storage.SaveTssShare(share); err != nil {
-			return errors.Wrap(err, "failed to save TSS share to vault")
+		switch tss.Curve(tssShareCurve) {
+		case tss.CurveSecp256k1:
+			var share *keygen.LocalPartySaveData
+			if err := json.Unmarshal(raw, &share); err != nil {
+				return errors.Wrap(err, "failed to unmarshal TSS share")
+			}
+			if err := storage.SaveTssShare(share); err != nil {
+				return errors.Wrap(err, "failed to save TSS share to vault")
+			}
+		case tss.CurveEd25519:
+			var share *eddsaKeygen.LocalPartySaveData
+			if err := json.Unmarshal(raw, &share); err != nil {
+				return errors.Wrap(err, "failed to unmarshal TSS EdDSA share")
+			}
+			if err := storage.SaveEddsaTssShare(share); err != nil {
+				return errors.Wrap(err, "failed to save TSS EdDSA share to vault")
+			}
+		default:
+			return errors.Errorf("invalid curve: %s", tssShareCurve)
 		}
 
 		config.Log().Info("TSS share was successfully saved")
@@ -0,0 +1,16 @@
+package admin
+
+import "github.com/spf13/cobra"
+
+func init() {
+	registerCommands(Cmd)
+}
+
+var Cmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Command for the bridge contracts administration signed by the TSS key",
+}
+
+func registerCommands(cmd *cobra.Command) {
+	cmd.AddCommand(solanaCmd)
+}
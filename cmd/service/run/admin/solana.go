@@ -0,0 +1,261 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	solanaAdmin "github.com/Bridgeless-Project/tss-svc/internal/tss/session/admin/solana"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	solanago "github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	wrappedDecimals  uint8
+	wrappedMintNonce uint64
+)
+
+func init() {
+	solanaCmd.AddCommand(solanaInitializeCmd, solanaChangeAuthorityCmd, solanaInitSplVaultCmd, solanaInitWrappedMintCmd)
+	registerInitWrappedMintOptions(solanaInitWrappedMintCmd)
+}
+
+func registerInitWrappedMintOptions(cmd *cobra.Command) {
+	cmd.Flags().Uint8Var(&wrappedDecimals, "decimals", 9, "Decimals of the wrapped token")
+	cmd.Flags().Uint64Var(&wrappedMintNonce, "mint-nonce", 0, "Nonce the wrapped token mint is derived with")
+}
+
+var solanaCmd = &cobra.Command{
+	Use:   "solana",
+	Short: "Commands for the Solana bridge program administration",
+	Long: "Commands for the Solana bridge program administration.\n" +
+		"Every command runs the consensus on the instruction between the parties, " +
+		"signs the authority message by the TSS key if the instruction is authorized by the bridge authority " +
+		"and submits the instruction from the session leader relayer key (see `helpers vault set relayer-key`).",
+}
+
+var solanaInitializeCmd = &cobra.Command{
+	Use:   "initialize [chain-id] [authorities...]",
+	Short: "Initializes the bridge with the given authorities, defaults to the current TSS public key",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSolanaAdmin(cmd, args[0], func(_ *solana.Client, tssKey [33]byte) (solana.AdminOperation, error) {
+			authorities, err := parseAuthorities(args[1:])
+			if err != nil {
+				return solana.AdminOperation{}, err
+			}
+			if len(authorities) == 0 {
+				authorities = [][33]byte{tssKey}
+			}
+
+			return solana.AdminOperation{
+				Action:      solana.AdminActionInitialize,
+				Authorities: authorities,
+			}, nil
+		})
+	},
+}
+
+var solanaChangeAuthorityCmd = &cobra.Command{
+	Use:   "change-authority [chain-id] [authorities...]",
+	Short: "Rotates the bridge authority to the given compressed secp256k1 public keys",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSolanaAdmin(cmd, args[0], func(client *solana.Client, _ [33]byte) (solana.AdminOperation, error) {
+			authorities, err := parseAuthorities(args[1:])
+			if err != nil {
+				return solana.AdminOperation{}, err
+			}
+			authority, err := client.Authority(context.Background())
+			if err != nil {
+				return solana.AdminOperation{}, errors.Wrap(err, "failed to get bridge authority")
+			}
+
+			return solana.AdminOperation{
+				Action:      solana.AdminActionChangeAuthority,
+				Authorities: authorities,
+				Nonce:       authority.AuthNonce + 1,
+			}, nil
+		})
+	},
+}
+
+var solanaInitSplVaultCmd = &cobra.Command{
+	Use:   "init-spl-vault [chain-id] [mint]",
+	Short: "Initializes the bridge vault for the SPL token",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSolanaAdmin(cmd, args[0], func(_ *solana.Client, _ [33]byte) (solana.AdminOperation, error) {
+			mint, err := solanago.PublicKeyFromBase58(args[1])
+			if err != nil {
+				return solana.AdminOperation{}, errors.Wrap(err, "failed to parse mint address")
+			}
+
+			return solana.AdminOperation{
+				Action: solana.AdminActionInitSplVault,
+				Mint:   mint,
+			}, nil
+		})
+	},
+}
+
+var solanaInitWrappedMintCmd = &cobra.Command{
+	Use:   "init-wrapped-mint [chain-id] [symbol] [name] [uri]",
+	Short: "Initializes the wrapped token minted by the bridge",
+	Args:  cobra.ExactArgs(4),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSolanaAdmin(cmd, args[0], func(_ *solana.Client, _ [33]byte) (solana.AdminOperation, error) {
+			return solana.AdminOperation{
+				Action: solana.AdminActionInitWrappedMint,
+				Wrapped: solana.WrappedMintParams{
+					WrappedMint: solana.WrappedMint{Symbol: args[1], MintNonce: wrappedMintNonce},
+					Name:        args[2],
+					Uri:         args[3],
+					Decimals:    wrappedDecimals,
+				},
+			}, nil
+		})
+	},
+}
+
+// operationFunc forms the administration operation from the command arguments,
+// the current TSS public key is provided in the compressed form.
+type operationFunc func(client *solana.Client, tssKey [33]byte) (solana.AdminOperation, error)
+
+func runSolanaAdmin(cmd *cobra.Command, chainId string, formOperation operationFunc) error {
+	cfg, err := utils.ConfigFromFlags(cmd)
+	if err != nil {
+		return errors.Wrap(err, "failed to get config from flags")
+	}
+
+	storage := cfg.SecretsStorage()
+	share, err := storage.GetTssShare()
+	if err != nil {
+		return errors.Wrap(err, "failed to get tss share")
+	}
+	account, err := storage.GetCoreAccount()
+	if err != nil {
+		return errors.Wrap(err, "failed to get core account")
+	}
+	cert, err := storage.GetLocalPartyTlsCertificate()
+	if err != nil {
+		return errors.Wrap(err, "failed to get local party TLS certificate")
+	}
+	parties := cfg.Parties()
+
+	var client *solana.Client
+	for _, ch := range cfg.Chains() {
+		if ch.Id == chainId && ch.Type == chain.TypeSolana {
+			client = solana.NewBridgeClient(solana.FromChain(ch))
+			break
+		}
+	}
+	if client == nil {
+		return errors.New("solana client configuration not found")
+	}
+
+	// only the session leader submits the instruction, so the relayer key is optional for the rest of the parties
+	var relayer *solana.Relayer
+	if rawKey, err := storage.GetRelayerKey(chainId); err == nil {
+		if relayer, err = solana.NewRelayer(client.Chain(), rawKey); err != nil {
+			return errors.Wrap(err, "failed to create relayer")
+		}
+	} else {
+		cfg.Log().WithError(err).Warn("relayer key not found, the instruction can not be submitted by the local party")
+	}
+
+	operation, err := formOperation(client, [33]byte(crypto.CompressPubkey(share.ECDSAPub.ToECDSAPubKey())))
+	if err != nil {
+		return errors.Wrap(err, "failed to form admin operation")
+	}
+
+	connectionManager := p2p.NewConnectionManager(
+		parties,
+		p2p.PartyStatus_PS_RESHARE,
+		cfg.Log().WithField("component", "connection_manager"),
+	)
+
+	session := solanaAdmin.NewSession(
+		tss.LocalSignParty{
+			Account:   *account,
+			Share:     share,
+			Threshold: cfg.TssSessionParams().Threshold,
+		},
+		client,
+		relayer,
+		solanaAdmin.SessionParams{
+			Operation:     operation,
+			SessionParams: cfg.TssSessionParams(),
+		},
+		parties,
+		connectionManager.GetReadyCount,
+		cfg.Log().WithField("component", "solana_admin_session"),
+	)
+
+	sessionManager := p2p.NewSessionManager(session)
+
+	eg := new(errgroup.Group)
+	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	eg.Go(func() error {
+		server := p2p.NewServer(
+			cfg.P2pGrpcListener(),
+			sessionManager,
+			parties,
+			*cert,
+			cfg.Log().WithField("component", "p2p_server"),
+		)
+		server.SetStatus(p2p.PartyStatus_PS_RESHARE)
+		return server.Run(ctx)
+	})
+
+	eg.Go(func() error {
+		defer cancel()
+
+		if err := session.Run(ctx); err != nil {
+			return errors.Wrap(err, "failed to run solana admin session")
+		}
+		txSig, err := session.WaitFor()
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain admin transaction signature")
+		}
+		if txSig == "" {
+			cfg.Log().Info("local party is not the session leader, the instruction is submitted by the leader")
+			return nil
+		}
+
+		cfg.Log().Infof("solana admin session for %s successfully completed", operation)
+		cfg.Log().Info(fmt.Sprintf("Transaction signature: %s", txSig))
+
+		return nil
+	})
+
+	return eg.Wait()
+}
+
+func parseAuthorities(raw []string) ([][33]byte, error) {
+	authorities := make([][33]byte, len(raw))
+	for i, key := range raw {
+		decoded, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to decode authority %s", key))
+		}
+		if _, err = crypto.DecompressPubkey(decoded); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("authority %s is not a compressed secp256k1 public key", key))
+		}
+		authorities[i] = [33]byte(decoded)
+	}
+
+	return authorities, nil
+}
@@ -51,14 +51,23 @@ func runServiceApiMode(ctx context.Context, cfg config.Config) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to create core connector")
 	}
-	clientsRepo := repository.NewClientsRepository(cfg.Clients())
+	clients := cfg.Clients()
+	clientsRepo := repository.NewClientsRepository(clients)
 	fetcher := deposit.NewFetcher(clientsRepo, connector)
 	dtb := pg.NewDepositsQ(cfg.DB())
+	addressesQ := pg.NewDepositAddressesQ(cfg.DB())
+	// the API process does not need the secret key share, the public key is enough to derive the deposit addresses
+	if pub := cfg.TssPublicKey(); pub != nil {
+		enableDepositAddresses(clients, pub, addressesQ)
+	} else {
+		logger.Warn("tss public key is not configured, deposit addresses are disabled")
+	}
 
 	apiServer := api.NewServer(
 		cfg.ApiGrpcListener(),
 		cfg.ApiHttpListener(),
 		dtb,
+		addressesQ,
 		logger.WithField("component", "api_server"),
 		clientsRepo,
 		fetcher,
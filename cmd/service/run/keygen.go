@@ -10,7 +10,6 @@ import (
 
 	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
-	"github.com/Bridgeless-Project/tss-svc/internal/secrets"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	keygenSession "github.com/Bridgeless-Project/tss-svc/internal/tss/session/keygen"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
@@ -19,8 +18,11 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+var keygenCurve = string(tss.DefaultCurve)
+
 func init() {
 	utils.RegisterOutputFlags(keygenCmd)
+	keygenCmd.Flags().StringVar(&keygenCurve, "curve", keygenCurve, "Curve of the generated key: secp256k1 (ECDSA) or ed25519 (EdDSA)")
 }
 
 var keygenCmd = &cobra.Command{
@@ -30,6 +32,9 @@ var keygenCmd = &cobra.Command{
 		if !utils.OutputValid() {
 			return errors.New("invalid output type")
 		}
+		if err := tss.Curve(keygenCurve).Validate(); err != nil {
+			return errors.Wrap(err, "invalid curve")
+		}
 
 		return nil
 	},
@@ -40,9 +45,13 @@ var keygenCmd = &cobra.Command{
 		}
 
 		storage := cfg.SecretsStorage()
-		preParams, err := storage.GetKeygenPreParams()
-		if err != nil {
-			return errors.Wrap(err, "failed to get keygen pre-parameters")
+		curve := tss.Curve(keygenCurve)
+		// pre-parameters are used by the ECDSA keygen only
+		preParams := &keygen.LocalPreParams{}
+		if curve == tss.CurveSecp256k1 {
+			if preParams, err = storage.GetKeygenPreParams(); err != nil {
+				return errors.Wrap(err, "failed to get keygen pre-parameters")
+			}
 		}
 		account, err := storage.GetCoreAccount()
 		if err != nil {
@@ -64,17 +73,39 @@ var keygenCmd = &cobra.Command{
 			cfg.Log().WithField("component", "connection_manager"),
 		)
 
-		session := keygenSession.NewSession(
-			tss.LocalKeygenParty{
-				PreParams: *preParams,
-				Address:   account.CosmosAddress(),
-				Threshold: cfg.TssSessionParams().Threshold,
-			},
-			parties,
-			cfg.TssSessionParams(),
-			connectionManager.GetReadyCount,
-			cfg.Log().WithField("component", "keygen_session"),
+		self := tss.LocalKeygenParty{
+			PreParams: *preParams,
+			Address:   account.CosmosAddress(),
+			Threshold: cfg.TssSessionParams().Threshold,
+		}
+		logger := cfg.Log().WithField("component", "keygen_session")
+
+		var (
+			session   p2p.TssSession
+			runKeygen func(ctx context.Context) error
 		)
+		switch curve {
+		case tss.CurveEd25519:
+			eddsaSession := keygenSession.NewEddsaSession(self, parties, cfg.TssSessionParams(), connectionManager.GetReadyCount, logger)
+			session, runKeygen = eddsaSession, func(ctx context.Context) error {
+				result, err := runKeygenSession(ctx, eddsaSession)
+				if err != nil {
+					return err
+				}
+
+				return storeKeygenResult(result, func() error { return storage.SaveEddsaTssShare(result) })
+			}
+		default:
+			ecdsaSession := keygenSession.NewSession(self, parties, cfg.TssSessionParams(), connectionManager.GetReadyCount, logger)
+			session, runKeygen = ecdsaSession, func(ctx context.Context) error {
+				result, err := runKeygenSession(ctx, ecdsaSession)
+				if err != nil {
+					return err
+				}
+
+				return storeKeygenResult(result, func() error { return storage.SaveTssShare(result) })
+			}
+		}
 
 		sessionManager := p2p.NewSessionManager(session)
 
@@ -93,24 +124,32 @@ var keygenCmd = &cobra.Command{
 		errGroup.Go(func() error {
 			defer cancel()
 
-			if err := session.Run(ctx); err != nil {
-				return errors.Wrap(err, "failed to run keygen session")
-			}
-			result, err := session.WaitFor()
-			if err != nil {
-				return errors.Wrap(err, "failed to obtain keygen session result")
+			if err := runKeygen(ctx); err != nil {
+				return err
 			}
 
 			cfg.Log().Info("keygen session successfully completed")
 
-			return storeKeygenResult(result, storage)
+			return nil
 		})
 
 		return errGroup.Wait()
 	},
 }
 
-func storeKeygenResult(result *keygen.LocalPartySaveData, storage secrets.Storage) error {
+func runKeygenSession[T tss.KeygenResult](ctx context.Context, session *keygenSession.Session[T]) (*T, error) {
+	if err := session.Run(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to run keygen session")
+	}
+	result, err := session.WaitFor()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain keygen session result")
+	}
+
+	return result, nil
+}
+
+func storeKeygenResult(result any, saveToVault func() error) error {
 	raw, err := json.Marshal(result)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal keygen result")
@@ -124,7 +163,7 @@ func storeKeygenResult(result *keygen.LocalPartySaveData, storage secrets.Storag
 			return errors.Wrap(err, "failed to write keygen result to file")
 		}
 	case "vault":
-		if err = storage.SaveTssShare(result); err != nil {
+		if err = saveToVault(); err != nil {
 			return errors.Wrap(err, "failed to save keygen result to vault")
 		}
 	}
@@ -0,0 +1,26 @@
+package reshare
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
+	"github.com/Bridgeless-Project/tss-svc/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var reshareEvmCmd = &cobra.Command{
+	Use:   "evm [chain-id] [new-pub-key]",
+	Short: "Command for the bridge signer and owner rotation during key resharing for EVM networks",
+	Long: "Command for the bridge signer and owner rotation during key resharing for EVM networks.\n" +
+		"The bridge contracts must be owned by the current TSS address, which sends the rotation transactions " +
+		"and should hold enough native tokens to pay for the gas.",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSignerRotation(cmd, chain.TypeEVM, args[0], args[1],
+			func(_ config.Config, ch chain.Chain, currentKey, newKey *ecdsa.PublicKey) (chain.SignerRotator, error) {
+				return evm.NewSignerRotator(evm.NewBridgeClient(evm.FromChain(ch)), currentKey, newKey), nil
+			},
+		)
+	},
+}
@@ -0,0 +1,246 @@
+package reshare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/core"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	keyResharing "github.com/Bridgeless-Project/tss-svc/internal/tss/session/resharing/key"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	oldCommittee []string
+	newCommittee []string
+	newThreshold int
+	generation   uint64
+)
+
+func init() {
+	registerReshareKeyOptions(reshareKeyCmd)
+}
+
+func registerReshareKeyOptions(cmd *cobra.Command) {
+	utils.RegisterOutputFlags(cmd)
+	cmd.Flags().StringSliceVar(&oldCommittee, "old-committee", nil, "Core addresses of the parties holding the current key shares")
+	cmd.Flags().StringSliceVar(&newCommittee, "new-committee", nil, "Core addresses of the parties receiving the new key shares")
+	cmd.Flags().IntVar(&newThreshold, "new-threshold", 0, "Signing threshold of the new committee")
+	cmd.Flags().Uint64Var(&generation, "generation", 0, "Generation of the current key shares (required for the new committee members only)")
+	_ = cmd.MarkFlagRequired("old-committee")
+	_ = cmd.MarkFlagRequired("new-committee")
+	_ = cmd.MarkFlagRequired("new-threshold")
+}
+
+var reshareKeyCmd = &cobra.Command{
+	Use:   "key",
+	Short: "Reshares the TSS key from the old committee to the new one",
+	Long: "Reshares the TSS key from the old committee to the new one keeping the same public key.\n" +
+		"The old committee threshold is taken from the TSS session parameters; " +
+		"the configured parties must include all the members of both committees.",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !utils.OutputValid() {
+			return errors.New("invalid output type")
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := utils.ConfigFromFlags(cmd)
+		if err != nil {
+			return errors.Wrap(err, "failed to get config from flags")
+		}
+
+		storage := cfg.SecretsStorage()
+		account, err := storage.GetCoreAccount()
+		if err != nil {
+			return errors.Wrap(err, "failed to get core account")
+		}
+		cert, err := storage.GetLocalPartyTlsCertificate()
+		if err != nil {
+			return errors.Wrap(err, "failed to get local party TLS certificate")
+		}
+
+		self := tss.LocalResharingParty{
+			Address:      account.CosmosAddress(),
+			Generation:   generation,
+			OldThreshold: cfg.TssSessionParams().Threshold,
+			NewThreshold: newThreshold,
+		}
+		if self.OldCommittee, err = parseCommittee(oldCommittee, self.OldThreshold); err != nil {
+			return errors.Wrap(err, "invalid old committee")
+		}
+		if self.NewCommittee, err = parseCommittee(newCommittee, self.NewThreshold); err != nil {
+			return errors.Wrap(err, "invalid new committee")
+		}
+
+		if self.IsOldCommitteeMember() {
+			if self.Share, err = storage.GetTssShare(); err != nil {
+				return errors.Wrap(err, "failed to get tss share")
+			}
+			shareGeneration := core.KeyGeneration(self.Share.ShareID)
+			if cmd.Flags().Changed("generation") && shareGeneration != generation {
+				return errors.New(fmt.Sprintf("generation mismatch: local share has %d, got %d", shareGeneration, generation))
+			}
+			self.Generation = shareGeneration
+		}
+		if self.IsNewCommitteeMember() {
+			preParams, err := storage.GetKeygenPreParams()
+			if err != nil {
+				return errors.Wrap(err, "failed to get keygen pre-parameters")
+			}
+			self.PreParams = *preParams
+		}
+		if !self.IsOldCommitteeMember() && !self.IsNewCommitteeMember() {
+			return errors.New("local party is not a member of any committee")
+		}
+
+		parties, err := committeeParties(cfg.Parties(), self)
+		if err != nil {
+			return errors.Wrap(err, "failed to get committee parties")
+		}
+
+		connectionManager := p2p.NewConnectionManager(
+			parties,
+			p2p.PartyStatus_PS_RESHARE,
+			cfg.Log().WithField("component", "connection_manager"),
+		)
+
+		session := keyResharing.NewSession(
+			self,
+			parties,
+			cfg.TssSessionParams(),
+			connectionManager.GetReadyCount,
+			cfg.Log().WithField("component", "key_reshare_session"),
+		)
+
+		sessionManager := p2p.NewSessionManager(session)
+
+		errGroup := new(errgroup.Group)
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+		defer cancel()
+
+		errGroup.Go(func() error {
+			server := p2p.NewServer(
+				cfg.P2pGrpcListener(),
+				sessionManager,
+				parties,
+				*cert,
+				cfg.Log().WithField("component", "p2p_server"),
+			)
+			server.SetStatus(p2p.PartyStatus_PS_RESHARE)
+			return server.Run(ctx)
+		})
+
+		errGroup.Go(func() error {
+			defer cancel()
+
+			if err := session.Run(ctx); err != nil {
+				return errors.Wrap(err, "failed to run key resharing session")
+			}
+			result, err := session.WaitFor()
+			if err != nil {
+				return errors.Wrap(err, "failed to obtain key resharing session result")
+			}
+			if result == nil {
+				cfg.Log().Info("local party is not a member of the new committee, its key share is no longer valid")
+				return nil
+			}
+			if self.Share != nil && !result.ECDSAPub.Equals(self.Share.ECDSAPub) {
+				return errors.New("reshared public key does not match the current one")
+			}
+
+			if err = storeResharingResult(result, storage.SaveTssShare); err != nil {
+				return errors.Wrap(err, "failed to store new key share")
+			}
+
+			cfg.Log().Info("key resharing session successfully completed")
+			cfg.Log().Info(fmt.Sprintf("New key share generation: %d", self.Generation+1))
+
+			return nil
+		})
+
+		return errGroup.Wait()
+	},
+}
+
+func parseCommittee(raw []string, threshold int) ([]core.Address, error) {
+	committee := make([]core.Address, len(raw))
+	unique := make(map[core.Address]struct{}, len(raw))
+	for i, str := range raw {
+		addr, err := core.AddressFromString(str)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address %s", str)
+		}
+		if _, exists := unique[addr]; exists {
+			return nil, errors.New(fmt.Sprintf("duplicated address %s", str))
+		}
+
+		unique[addr] = struct{}{}
+		committee[i] = addr
+	}
+
+	if threshold <= 0 || threshold >= len(committee) {
+		return nil, errors.New(fmt.Sprintf("threshold %d is invalid for %d parties", threshold, len(committee)))
+	}
+
+	return committee, nil
+}
+
+// committeeParties selects the configured parties that are members of any committee
+// ensuring all the members are configured.
+func committeeParties(configured []p2p.Party, self tss.LocalResharingParty) ([]p2p.Party, error) {
+	byAddress := make(map[core.Address]p2p.Party, len(configured))
+	for _, party := range configured {
+		byAddress[party.CoreAddress] = party
+	}
+
+	var parties []p2p.Party
+	selected := map[core.Address]struct{}{self.Address: {}}
+	for _, member := range append(self.OldCommittee, self.NewCommittee...) {
+		if _, exists := selected[member]; exists {
+			continue
+		}
+
+		party, exists := byAddress[member]
+		if !exists {
+			return nil, errors.New(fmt.Sprintf("party %s is not configured", member))
+		}
+
+		selected[member] = struct{}{}
+		parties = append(parties, party)
+	}
+
+	return parties, nil
+}
+
+func storeResharingResult(result *keygen.LocalPartySaveData, saveToVault func(*keygen.LocalPartySaveData) error) error {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal resharing result")
+	}
+
+	switch utils.OutputType {
+	case "console":
+		fmt.Println(string(raw))
+	case "file":
+		if err = os.WriteFile(utils.FilePath, raw, 0644); err != nil {
+			return errors.Wrap(err, "failed to write resharing result to file")
+		}
+	case "vault":
+		if err = saveToVault(result); err != nil {
+			return errors.Wrap(err, "failed to save resharing result to vault")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package reshare
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os/signal"
+	"syscall"
+
+	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/config"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/resharing/rotation"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// rotatorFunc creates the chain signer rotator from the chain configuration and the current TSS public key.
+type rotatorFunc func(cfg config.Config, ch chain.Chain, currentKey, newKey *ecdsa.PublicKey) (chain.SignerRotator, error)
+
+// runSignerRotation rotates the bridge signer of the given chain from the current TSS key to the new one.
+func runSignerRotation(cmd *cobra.Command, chainType chain.Type, chainId, rawNewKey string, newRotator rotatorFunc) error {
+	cfg, err := utils.ConfigFromFlags(cmd)
+	if err != nil {
+		return errors.Wrap(err, "failed to get config from flags")
+	}
+
+	newKey, err := parsePublicKey(rawNewKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse new public key")
+	}
+
+	storage := cfg.SecretsStorage()
+	share, err := storage.GetTssShare()
+	if err != nil {
+		return errors.Wrap(err, "failed to get tss share")
+	}
+	account, err := storage.GetCoreAccount()
+	if err != nil {
+		return errors.Wrap(err, "failed to get core account")
+	}
+	cert, err := storage.GetLocalPartyTlsCertificate()
+	if err != nil {
+		return errors.Wrap(err, "failed to get local party TLS certificate")
+	}
+	parties := cfg.Parties()
+
+	var rotator chain.SignerRotator
+	for _, ch := range cfg.Chains() {
+		if ch.Id == chainId && ch.Type == chainType {
+			if rotator, err = newRotator(cfg, ch, share.ECDSAPub.ToECDSAPubKey(), newKey); err != nil {
+				return errors.Wrap(err, "failed to create signer rotator")
+			}
+			break
+		}
+	}
+	if rotator == nil {
+		return errors.New(fmt.Sprintf("%s chain %s configuration not found", chainType, chainId))
+	}
+
+	connectionManager := p2p.NewConnectionManager(
+		parties,
+		p2p.PartyStatus_PS_RESHARE,
+		cfg.Log().WithField("component", "connection_manager"),
+	)
+
+	session := rotation.NewSession(
+		tss.LocalSignParty{
+			Account:   *account,
+			Share:     share,
+			Threshold: cfg.TssSessionParams().Threshold,
+		},
+		rotator,
+		rotation.SessionParams{
+			SessionParams: cfg.TssSessionParams(),
+		},
+		parties,
+		connectionManager.GetReadyCount,
+		cfg.Log().WithField("component", fmt.Sprintf("%s_reshare_session", chainType)),
+	)
+
+	sessionManager := p2p.NewSessionManager(session)
+
+	eg := new(errgroup.Group)
+	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	eg.Go(func() error {
+		server := p2p.NewServer(
+			cfg.P2pGrpcListener(),
+			sessionManager,
+			parties,
+			*cert,
+			cfg.Log().WithField("component", "p2p_server"),
+		)
+		server.SetStatus(p2p.PartyStatus_PS_RESHARE)
+		return server.Run(ctx)
+	})
+
+	eg.Go(func() error {
+		defer cancel()
+
+		if err := session.Run(ctx); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to run %s resharing session", chainType))
+		}
+		txRef, err := session.WaitFor()
+		if err != nil {
+			return errors.Wrap(err, "failed to rotate bridge signer")
+		}
+		if txRef == "" {
+			cfg.Log().Info("local party is not the session leader, the rotation is submitted by the leader")
+			return nil
+		}
+
+		cfg.Log().Infof("%s resharing session for chain %q successfully completed", chainType, chainId)
+		cfg.Log().Info(fmt.Sprintf("Rotation transactions: %s", txRef))
+
+		return nil
+	})
+
+	return eg.Wait()
+}
+
+// parsePublicKey parses the hex-encoded compressed or uncompressed secp256k1 public key.
+func parsePublicKey(raw string) (*ecdsa.PublicKey, error) {
+	decoded, err := hexutil.Decode(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+	if len(decoded) == 33 {
+		return crypto.DecompressPubkey(decoded)
+	}
+
+	return crypto.UnmarshalPubkey(decoded)
+}
+
+// relayerKey returns the chain relayer key if it is set. Only the session leader submits the rotation,
+// so the key is optional for the rest of the parties.
+func relayerKey(cfg config.Config, chainId string) (string, bool) {
+	rawKey, err := cfg.SecretsStorage().GetRelayerKey(chainId)
+	if err != nil {
+		cfg.Log().WithError(err).Warn("relayer key not found, the rotation can not be submitted by the local party")
+		return "", false
+	}
+
+	return rawKey, true
+}
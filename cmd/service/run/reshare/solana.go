@@ -0,0 +1,34 @@
+package reshare
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
+	"github.com/Bridgeless-Project/tss-svc/internal/config"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var reshareSolanaCmd = &cobra.Command{
+	Use:   "solana [chain-id] [new-pub-key]",
+	Short: "Command for the bridge authority rotation during key resharing for Solana networks",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSignerRotation(cmd, chain.TypeSolana, args[0], args[1],
+			func(cfg config.Config, ch chain.Chain, _, newKey *ecdsa.PublicKey) (chain.SignerRotator, error) {
+				client := solana.NewBridgeClient(solana.FromChain(ch))
+
+				var relayer *solana.Relayer
+				if rawKey, ok := relayerKey(cfg, ch.Id); ok {
+					var err error
+					if relayer, err = solana.NewRelayer(client.Chain(), rawKey); err != nil {
+						return nil, errors.Wrap(err, "failed to create relayer")
+					}
+				}
+
+				return solana.NewSignerRotator(client, relayer, newKey), nil
+			},
+		)
+	},
+}
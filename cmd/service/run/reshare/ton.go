@@ -0,0 +1,36 @@
+package reshare
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/ton"
+	"github.com/Bridgeless-Project/tss-svc/internal/config"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var reshareTonCmd = &cobra.Command{
+	Use:   "ton [chain-id] [new-pub-key]",
+	Short: "Command for the bridge signer rotation during key resharing for TON networks",
+	Long: "Command for the bridge signer rotation during key resharing for TON networks.\n" +
+		"Requires the chain `meta.rotation` settings describing the bridge contract change signer message.",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSignerRotation(cmd, chain.TypeTON, args[0], args[1],
+			func(cfg config.Config, ch chain.Chain, _, newKey *ecdsa.PublicKey) (chain.SignerRotator, error) {
+				client := ton.NewBridgeClient(ton.FromChain(ch))
+
+				var relayer *ton.Relayer
+				if rawKey, ok := relayerKey(cfg, ch.Id); ok {
+					var err error
+					if relayer, err = ton.NewRelayer(client, rawKey); err != nil {
+						return nil, errors.Wrap(err, "failed to create relayer")
+					}
+				}
+
+				return ton.NewSignerRotator(client, relayer, newKey)
+			},
+		)
+	},
+}
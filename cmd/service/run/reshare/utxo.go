@@ -11,6 +11,7 @@ import (
 	utxochain "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/chain"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
 	utxoutils "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
+	pg "github.com/Bridgeless-Project/tss-svc/internal/db/postgres"
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	utxoResharing "github.com/Bridgeless-Project/tss-svc/internal/tss/session/resharing/utxo"
@@ -27,7 +28,7 @@ func init() {
 }
 
 func registerReshareUtxoOptions(cmd *cobra.Command) {
-	cmd.Flags().Uint64Var(&consolidateParams.FeeRate, "fee-rate", consolidateParams.FeeRate, "Fee rate for the transaction (sats/KvB)")
+	cmd.Flags().Uint64Var(&consolidateParams.FeeRate, "fee-rate", consolidateParams.FeeRate, "Fee rate for the transaction (sats/KvB), defaults to the chain minimum")
 	cmd.Flags().IntVar(&consolidateParams.OutputsCount, "outputs-count", consolidateParams.OutputsCount, "Number of outputs to split the funds into")
 	cmd.Flags().IntVar(&consolidateParams.MaxInputsCount, "max-inputs-count", consolidateParams.MaxInputsCount, "Maximum number of inputs to use in the transaction")
 }
@@ -35,7 +36,10 @@ func registerReshareUtxoOptions(cmd *cobra.Command) {
 var reshareUtxoCmd = &cobra.Command{
 	Use:   "utxo [chain-id] [target-addr]",
 	Short: "Command for service migration during key resharing for utxo chains",
-	Args:  cobra.ExactArgs(2),
+	Long: "Command for service migration during key resharing for utxo chains.\n" +
+		"If the target address is omitted, funds are moved to the current TSS wallet address of the configured type " +
+		"(f.e. to migrate funds from the P2PKH to the P2WPKH address).",
+	Args: cobra.RangeArgs(1, 2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := utils.ConfigFromFlags(cmd)
 		if err != nil {
@@ -67,7 +71,15 @@ var reshareUtxoCmd = &cobra.Command{
 		if cli == nil {
 			return errors.New("utxo client configuration not found")
 		}
-		targetAddr := args[1]
+		// deposit addresses outputs are signed by the derived keys, so they are not migrated
+		cli.WithDepositAddresses(share.ECDSAPub.ToECDSAPubKey(), pg.NewDepositAddressesQ(cfg.DB()))
+		if !cmd.Flags().Changed("fee-rate") {
+			consolidateParams.FeeRate = uint64(cli.UtxoHelper().MinFeeRate())
+		}
+		targetAddr := cli.UtxoHelper().WalletAddress(share.ECDSAPub.ToECDSAPubKey())
+		if len(args) == 2 {
+			targetAddr = args[1]
+		}
 		if !cli.UtxoHelper().AddressValid(targetAddr) {
 			return errors.New(fmt.Sprintf("invalid target address: %s", targetAddr))
 		}
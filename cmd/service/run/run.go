@@ -1,6 +1,7 @@
 package run
 
 import (
+	"github.com/Bridgeless-Project/tss-svc/cmd/service/run/admin"
 	"github.com/Bridgeless-Project/tss-svc/cmd/service/run/reshare"
 	"github.com/spf13/cobra"
 )
@@ -15,5 +16,5 @@ var Cmd = &cobra.Command{
 }
 
 func registerCommands(cmd *cobra.Command) {
-	cmd.AddCommand(keygenCmd, signCmd, reshare.Cmd, apiCmd)
+	cmd.AddCommand(keygenCmd, signCmd, reshare.Cmd, admin.Cmd, apiCmd)
 }
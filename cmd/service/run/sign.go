@@ -2,6 +2,7 @@ package run
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"os/signal"
 	"sync"
@@ -9,29 +10,38 @@ import (
 
 	"github.com/Bridgeless-Project/tss-svc/cmd/utils"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/cosmos"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/plugin"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/repository"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/ton"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/tron"
 	utxoclient "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/zano"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/deposit"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/indexer"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
 	"github.com/Bridgeless-Project/tss-svc/internal/config"
-	"github.com/Bridgeless-Project/tss-svc/internal/core"
 	coreConnector "github.com/Bridgeless-Project/tss-svc/internal/core/connector"
 	"github.com/Bridgeless-Project/tss-svc/internal/core/subscriber"
 	"github.com/Bridgeless-Project/tss-svc/internal/db"
 	pg "github.com/Bridgeless-Project/tss-svc/internal/db/postgres"
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/secrets"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/distributor"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/refresh"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
+	cosmosSigning "github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing/cosmos"
 	evmSigning "github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing/evm"
+	pluginSigning "github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing/plugin"
 	solanaSigning "github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing/solana"
 	tonSigning "github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing/ton"
 	utxoSigning "github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing/utxo"
 	zanoSigning "github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing/zano"
-	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	eddsaKeygen "github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"gitlab.com/distributed_lab/logan/v3"
@@ -80,6 +90,21 @@ func runSigningServiceMode(ctx context.Context, cfg config.Config) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to get local party tls certificate")
 	}
+	curves := make(map[string]tss.Curve)
+	batchSizes := make(map[string]uint64)
+	var eddsaShare *eddsaKeygen.LocalPartySaveData
+	for _, ch := range cfg.Chains() {
+		curves[ch.Id] = ch.Curve
+		batchSizes[ch.Id] = ch.BatchSize
+		if batchSizes[ch.Id] == 0 {
+			batchSizes[ch.Id] = session.MaxSigningBatchSize
+		}
+		if ch.Curve == tss.CurveEd25519 && eddsaShare == nil {
+			if eddsaShare, err = storage.GetEddsaTssShare(); err != nil {
+				return errors.Wrap(err, "failed to get tss eddsa share")
+			}
+		}
+	}
 
 	wg := new(sync.WaitGroup)
 	eg, ctx := errgroup.WithContext(ctx)
@@ -89,6 +114,8 @@ func runSigningServiceMode(ctx context.Context, cfg config.Config) error {
 	clientsRepo := repository.NewClientsRepository(clients)
 	sessionManager := p2p.NewSessionManager()
 	dtb := pg.NewDepositsQ(cfg.DB())
+	addressesQ := pg.NewDepositAddressesQ(cfg.DB())
+	enableDepositAddresses(clients, share.ECDSAPub.ToECDSAPubKey(), addressesQ)
 	connector, err := coreConnector.NewConnector(
 		*account,
 		cfg.CoreConnectorConfig().Connection,
@@ -124,6 +151,34 @@ func runSigningServiceMode(ctx context.Context, cfg config.Config) error {
 		return errors.Wrap(p2pServer.Run(ctx), "error while running p2p server")
 	})
 
+	// key share refresh spin-up
+	var pause session.Pause
+	if refreshSettings := cfg.TssShareRefresh(); refreshSettings.Enabled {
+		schedule := refresh.Schedule{
+			StartTime: cfg.TssSessionParams().StartTime,
+			Interval:  refreshSettings.Interval,
+		}
+		// signing sessions are paused during the refresh to use the same share
+		pause = schedule
+
+		refreshSession := refresh.NewSession(
+			account.CosmosAddress(),
+			share,
+			cfg.TssSessionParams().Threshold,
+			parties,
+			schedule,
+			storage,
+			logger.WithField("component", "share_refresh_session"),
+		)
+		sessionManager.Add(refreshSession)
+
+		wg.Add(1)
+		eg.Go(func() error {
+			defer wg.Done()
+			return errors.Wrap(refreshSession.Run(ctx), "error while running key share refresh session")
+		})
+	}
+
 	// sessions spin-up
 	var snc *p2p.Syncer
 	if syncEnabled {
@@ -156,7 +211,14 @@ func runSigningServiceMode(ctx context.Context, cfg config.Config) error {
 				}
 			}
 
-			sess := configureSigningSession(sessParams, parties, *account, share, dtb, fetcher, logger, client, connector)
+			self := tss.LocalSignParty{
+				Account:    *account,
+				Share:      share,
+				EddsaShare: eddsaShare,
+				Curve:      curves[client.ChainId()],
+				Threshold:  sessParams.Threshold,
+			}
+			sess := configureSigningSession(sessParams, parties, self, dtb, fetcher, logger, client, connector, storage, pause, batchSizes[client.ChainId()])
 
 			wg.Add(1)
 			eg.Go(func() error {
@@ -170,6 +232,24 @@ func runSigningServiceMode(ctx context.Context, cfg config.Config) error {
 		})
 	}
 
+	// deposit indexers spin-up
+	cursorsQ := pg.NewBlockCursorsQ(cfg.DB())
+	for _, client := range clients {
+		idx := configureDepositIndexer(client, fetcher, connector, dtb, cursorsQ, logger)
+		if idx == nil {
+			continue
+		}
+
+		wg.Add(1)
+		eg.Go(func() error {
+			defer wg.Done()
+
+			idx.Run(ctx)
+
+			return nil
+		})
+	}
+
 	// additional deposit acceptor session
 	wg.Add(1)
 	eg.Go(func() error {
@@ -188,6 +268,24 @@ func runSigningServiceMode(ctx context.Context, cfg config.Config) error {
 		return nil
 	})
 
+	// deposit addresses distribution session
+	wg.Add(1)
+	eg.Go(func() error {
+		defer wg.Done()
+
+		addressesSession := distributor.NewDepositAddressDistributionSession(
+			account.CosmosAddress(),
+			parties,
+			clientsRepo,
+			addressesQ,
+			logger.WithField("component", "deposit_address_distribution_session"),
+		)
+		sessionManager.Add(addressesSession)
+		addressesSession.Run(ctx)
+
+		return nil
+	})
+
 	// Core deposit subscriber spin-up
 	wg.Add(1)
 	eg.Go(func() error {
@@ -216,97 +314,217 @@ func runSigningServiceMode(ctx context.Context, cfg config.Config) error {
 func configureSigningSession(
 	params session.SigningParams,
 	parties []p2p.Party,
-	account core.Account,
-	share *keygen.LocalPartySaveData,
+	self tss.LocalSignParty,
 	db db.DepositsQ,
 	fetcher *deposit.Fetcher,
 	logger *logan.Entry,
 	client chain.Client,
 	connector *coreConnector.Connector,
+	storage secrets.Storage,
+	pause session.Pause,
+	batchSize uint64,
 ) (sess p2p.RunnableTssSession) {
+	sessionLogger := logger.WithField("component", "signing_session")
+	// withdrawals are processed via the primary provider,
+	// the deposits are still verified by the quorum through the fetcher
+	client = chain.Unwrap(client)
+
 	switch client.Type() {
 	case chain.TypeEVM:
-		evmSession := evmSigning.NewSession(
-			tss.LocalSignParty{
-				Account:   account,
-				Share:     share,
-				Threshold: params.Threshold,
-			},
-			parties,
-			params,
-			db,
-			logger.WithField("component", "signing_session"),
-		).WithDepositFetcher(fetcher).WithClient(client.(*evm.Client)).WithCoreConnector(connector)
-		if err := evmSession.Build(); err != nil {
-			panic(errors.Wrap(err, "failed to build evm session"))
+		evmClient := client.(*evm.Client)
+		var relayer *evm.Relayer
+		if evmClient.Chain().Meta.Relayer.Enabled {
+			relayer = mustCreateEvmRelayer(evmClient, storage)
 		}
-		sess = evmSession
+		sess = mustBuildSigningSession(
+			signing.NewSession[withdrawal.EvmWithdrawalData](self, parties, params, db, sessionLogger).
+				WithConstructor(withdrawal.NewEvmConstructor(evmClient), batchSize).
+				WithFinalizer(evmSigning.NewFinalizerFactory(db, relayer)),
+			fetcher, pause,
+		)
 	case chain.TypeZano:
-		zanoSession := zanoSigning.NewSession(
-			tss.LocalSignParty{
-				Account:   account,
-				Share:     share,
-				Threshold: params.Threshold,
-			},
-			parties,
-			params,
-			db,
-			logger.WithField("component", "signing_session"),
-		).WithDepositFetcher(fetcher).WithClient(client.(*zano.Client)).WithCoreConnector(connector)
-		if err := zanoSession.Build(); err != nil {
-			panic(errors.Wrap(err, "failed to build zano session"))
-		}
-		sess = zanoSession
+		zanoClient := client.(*zano.Client)
+		sess = mustBuildSigningSession(
+			signing.NewSession[withdrawal.ZanoWithdrawalData](self, parties, params, db, sessionLogger).
+				// withdrawals are not batched, every deposit is processed by a separate transaction
+				WithConstructor(withdrawal.NewZanoConstructor(zanoClient), 1).
+				WithFinalizer(zanoSigning.NewFinalizerFactory(db, zanoClient)),
+			fetcher, pause,
+		)
 	case chain.TypeBitcoin:
 		btcSession := utxoSigning.NewSession(
-			tss.LocalSignParty{
-				Account:   account,
-				Share:     share,
-				Threshold: params.Threshold,
-			},
+			self,
 			parties,
 			params,
 			db,
-			logger.WithField("component", "signing_session"),
-		).WithDepositFetcher(fetcher).WithClient(client.(utxoclient.Client)).WithCoreConnector(connector)
+			sessionLogger,
+		).WithDepositFetcher(fetcher).WithClient(client.(utxoclient.Client)).WithCoreConnector(connector).WithPause(pause).WithBatchSize(batchSize)
 		if err := btcSession.Build(); err != nil {
 			panic(errors.Wrap(err, "failed to build bitcoin session"))
 		}
 		sess = btcSession
 
 	case chain.TypeTON:
-		tonSession := tonSigning.NewSession(tss.LocalSignParty{
-			Account:   account,
-			Share:     share,
-			Threshold: params.Threshold,
-		},
-			parties,
-			params,
-			db,
-			logger.WithField("component", "signing_session"),
-		).WithDepositFetcher(fetcher).WithClient(client.(*ton.Client)).WithCoreConnector(connector)
-		if err := tonSession.Build(); err != nil {
-			panic(errors.Wrap(err, "failed to build TON session"))
+		tonClient := client.(*ton.Client)
+		var relayer *ton.Relayer
+		if tonClient.Meta.Relayer.Enabled {
+			relayer = mustCreateTonRelayer(tonClient, storage)
 		}
-		sess = tonSession
+		sess = mustBuildSigningSession(
+			signing.NewSession[withdrawal.TonWithdrawalData](self, parties, params, db, sessionLogger).
+				WithConstructor(withdrawal.NewTonConstructor(tonClient), batchSize).
+				WithFinalizer(tonSigning.NewFinalizerFactory(db, relayer)),
+			fetcher, pause,
+		)
 
 	case chain.TypeSolana:
-		solanaSession := solanaSigning.NewSession(
-			tss.LocalSignParty{
-				Account:   account,
-				Share:     share,
-				Threshold: params.Threshold,
-			},
-			parties,
-			params,
-			db,
-			logger.WithField("component", "signing_session"),
-		).WithDepositFetcher(fetcher).WithClient(client.(*solana.Client)).WithCoreConnector(connector)
-		if err := solanaSession.Build(); err != nil {
-			panic(errors.Wrap(err, "failed to build solana session"))
+		solanaClient := client.(*solana.Client)
+		var relayer *solana.Relayer
+		if solanaClient.Chain().Meta.Relayer.Enabled {
+			relayer = mustCreateSolanaRelayer(solanaClient, storage)
+		}
+		sess = mustBuildSigningSession(
+			signing.NewSession[withdrawal.SolanaWithdrawalData](self, parties, params, db, sessionLogger).
+				WithConstructor(withdrawal.NewSolanaConstructor(solanaClient), batchSize).
+				WithFinalizer(solanaSigning.NewFinalizerFactory(db, relayer)),
+			fetcher, pause,
+		)
+
+	case chain.TypeCosmos:
+		cosmosClient := client.(*cosmos.Client)
+		constructor, err := cosmosSigning.NewConstructor(cosmosClient, self.Share.ECDSAPub.ToECDSAPubKey())
+		if err != nil {
+			panic(errors.Wrap(err, "failed to create cosmos withdrawal constructor"))
 		}
-		sess = solanaSession
+		sess = mustBuildSigningSession(
+			signing.NewSession[withdrawal.CosmosWithdrawalData](self, parties, params, db, sessionLogger).
+				// withdrawals are not batched, every deposit is processed by a separate transaction
+				WithConstructor(constructor, 1).
+				WithFinalizer(cosmosSigning.NewFinalizerFactory(db, cosmosClient)),
+			fetcher, pause,
+		)
+	case chain.TypeTron:
+		// TRON bridge contract is the TVM port of the EVM one, withdrawals are claimed by the users
+		sess = mustBuildSigningSession(
+			signing.NewSession[withdrawal.EvmWithdrawalData](self, parties, params, db, sessionLogger).
+				WithConstructor(withdrawal.NewEvmConstructor(client.(*tron.Client)), batchSize).
+				WithFinalizer(evmSigning.NewFinalizerFactory(db, nil)),
+			fetcher, pause,
+		)
+	case chain.TypePlugin:
+		pluginClient := client.(*plugin.Client)
+		sess = mustBuildSigningSession(
+			signing.NewSession[withdrawal.PluginWithdrawalData](self, parties, params, db, sessionLogger).
+				WithConstructor(withdrawal.NewPluginConstructor(pluginClient, int(batchSize)), batchSize).
+				WithFinalizer(pluginSigning.NewFinalizerFactory(db, pluginClient)),
+			fetcher, pause,
+		)
 	}
 
 	return sess
 }
+
+func mustBuildSigningSession[T signing.SessionData](
+	sess *signing.Session[T],
+	fetcher *deposit.Fetcher,
+	pause session.Pause,
+) *signing.Session[T] {
+	if err := sess.WithDepositFetcher(fetcher).WithPause(pause).Build(); err != nil {
+		panic(errors.Wrap(err, "failed to build signing session"))
+	}
+
+	return sess
+}
+
+// enableDepositAddresses enables the per-receiver deposit addresses for the Bitcoin chains
+func enableDepositAddresses(clients []chain.Client, pub *ecdsa.PublicKey, addresses db.DepositAddressesQ) {
+	for _, client := range clients {
+		if client.Type() != chain.TypeBitcoin {
+			continue
+		}
+		for _, provider := range chain.UnwrapAll(client) {
+			provider.(utxoclient.Client).WithDepositAddresses(pub, addresses)
+		}
+	}
+}
+
+func mustCreateEvmRelayer(client *evm.Client, storage secrets.Storage) *evm.Relayer {
+	rawKey, err := storage.GetRelayerKey(client.ChainId())
+	if err != nil {
+		panic(errors.Wrap(err, fmt.Sprintf("failed to get relayer key for chain %s", client.ChainId())))
+	}
+
+	relayer, err := evm.NewRelayer(client.Chain(), rawKey)
+	if err != nil {
+		panic(errors.Wrap(err, fmt.Sprintf("failed to create relayer for chain %s", client.ChainId())))
+	}
+
+	return relayer
+}
+
+func mustCreateSolanaRelayer(client *solana.Client, storage secrets.Storage) *solana.Relayer {
+	rawKey, err := storage.GetRelayerKey(client.ChainId())
+	if err != nil {
+		panic(errors.Wrap(err, fmt.Sprintf("failed to get relayer key for chain %s", client.ChainId())))
+	}
+
+	relayer, err := solana.NewRelayer(client.Chain(), rawKey)
+	if err != nil {
+		panic(errors.Wrap(err, fmt.Sprintf("failed to create relayer for chain %s", client.ChainId())))
+	}
+
+	return relayer
+}
+
+func mustCreateTonRelayer(client *ton.Client, storage secrets.Storage) *ton.Relayer {
+	rawKey, err := storage.GetRelayerKey(client.ChainId())
+	if err != nil {
+		panic(errors.Wrap(err, fmt.Sprintf("failed to get relayer key for chain %s", client.ChainId())))
+	}
+
+	relayer, err := ton.NewRelayer(client, rawKey)
+	if err != nil {
+		panic(errors.Wrap(err, fmt.Sprintf("failed to create relayer for chain %s", client.ChainId())))
+	}
+
+	return relayer
+}
+
+// configureDepositIndexer returns nil if deposits indexing is not supported or disabled for the chain
+func configureDepositIndexer(
+	client chain.Client,
+	fetcher *deposit.Fetcher,
+	connector *coreConnector.Connector,
+	deposits db.DepositsQ,
+	cursors db.BlockCursorsQ,
+	logger *logan.Entry,
+) *indexer.Indexer {
+	var (
+		scanner  indexer.Scanner
+		settings indexer.Settings
+	)
+
+	switch client.Type() {
+	case chain.TypeEVM:
+		// the quorum client verifies the confirmed height by all the providers
+		scanner, settings = client.(indexer.Scanner), chain.Unwrap(client).(*evm.Client).Chain().Meta.Indexer
+	case chain.TypeBitcoin:
+		scanner, settings = client.(indexer.Scanner), chain.Unwrap(client).(utxoclient.Client).IndexerSettings()
+	default:
+		return nil
+	}
+
+	if !settings.Enabled {
+		return nil
+	}
+
+	return indexer.New(
+		scanner,
+		settings,
+		fetcher,
+		connector,
+		deposits,
+		cursors,
+		logger.WithField("component", "deposit_indexer"),
+	)
+}
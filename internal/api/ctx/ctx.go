@@ -20,6 +20,7 @@ const (
 	processorKey
 	coreConnectorKey
 	healthCheckerKey
+	depositAddressesKey
 )
 
 func DBProvider(q db.DepositsQ) func(context.Context) context.Context {
@@ -82,3 +83,14 @@ func HealthCheckerProvider(checker *health.Checker) func(context.Context) contex
 func HealthChecker(ctx context.Context) *health.Checker {
 	return ctx.Value(healthCheckerKey).(*health.Checker)
 }
+
+func DepositAddressesProvider(q db.DepositAddressesQ) func(context.Context) context.Context {
+	return func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, depositAddressesKey, q)
+	}
+}
+
+// DepositAddresses always returns unique connection
+func DepositAddresses(ctx context.Context) db.DepositAddressesQ {
+	return ctx.Value(depositAddressesKey).(db.DepositAddressesQ).New()
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/api/ctx"
+	apiTypes "github.com/Bridgeless-Project/tss-svc/internal/api/types"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	utxoclient "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (Implementation) GetDepositAddress(ctxt context.Context, request *apiTypes.DepositAddressRequest) (*apiTypes.DepositAddressResponse, error) {
+	if err := validateDepositAddressRequest(request); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var (
+		clientsRepo = ctx.Clients(ctxt)
+		addresses   = ctx.DepositAddresses(ctxt)
+		logger      = ctx.Logger(ctxt)
+	)
+
+	client, err := clientsRepo.Client(request.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unsupported chain")
+	}
+	if client.Type() != chain.TypeBitcoin {
+		return nil, status.Error(codes.InvalidArgument, "deposit addresses are not supported for the chain")
+	}
+	dstClient, err := clientsRepo.Client(request.DestinationChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unsupported destination chain")
+	}
+	if !dstClient.AddressValid(request.Receiver) {
+		return nil, status.Error(codes.InvalidArgument, "invalid receiver address")
+	}
+
+	utxoClient := chain.Unwrap(client).(utxoclient.Client)
+	if !utxoClient.DepositAddressesEnabled() {
+		return nil, status.Error(codes.Unimplemented, "deposit addresses are not enabled")
+	}
+
+	address, err := utxoClient.DepositAddress(request.DestinationChainId, request.Receiver)
+	if err != nil {
+		logger.WithError(err).Error("failed to derive deposit address")
+		return nil, ErrInternal
+	}
+
+	if err = addresses.Insert(db.DepositAddress{
+		ChainId:            request.ChainId,
+		Address:            address,
+		DestinationChainId: request.DestinationChainId,
+		DestinationAddress: request.Receiver,
+	}); err != nil {
+		logger.WithError(err).Error("failed to save deposit address")
+		return nil, ErrInternal
+	}
+
+	return &apiTypes.DepositAddressResponse{Address: address}, nil
+}
+
+func validateDepositAddressRequest(request *apiTypes.DepositAddressRequest) error {
+	return validation.Errors{
+		"chain_id":             validation.Validate(request.ChainId, validation.Required),
+		"destination_chain_id": validation.Validate(request.DestinationChainId, validation.Required),
+		"receiver":             validation.Validate(request.Receiver, validation.Required),
+	}.Filter()
+}
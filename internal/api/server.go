@@ -33,6 +33,7 @@ type Server struct {
 	http net.Listener
 
 	db        db.DepositsQ
+	addresses db.DepositAddressesQ
 	logger    *logan.Entry
 	clients   chain.Repository
 	processor *deposit.Fetcher
@@ -44,6 +45,7 @@ func NewServer(
 	grpc net.Listener,
 	http net.Listener,
 	db db.DepositsQ,
+	addresses db.DepositAddressesQ,
 	logger *logan.Entry,
 	clients chain.Repository,
 	processor *deposit.Fetcher,
@@ -54,6 +56,7 @@ func NewServer(
 		http:      http,
 		logger:    logger,
 		db:        db,
+		addresses: addresses,
 		clients:   clients,
 		processor: processor,
 		connector: connector,
@@ -100,6 +103,7 @@ func (s *Server) httpRouter(ctxt context.Context) http.Handler {
 		ape.CtxMiddleware(
 			ctx.LoggerProvider(s.logger),
 			ctx.DBProvider(s.db),
+			ctx.DepositAddressesProvider(s.addresses),
 			ctx.ClientsProvider(s.clients),
 			ctx.FetcherProvider(s.processor),
 			ctx.CoreConnectorProvider(s.connector),
@@ -128,6 +132,7 @@ func (s *Server) grpcServer() *grpc.Server {
 			middlewares.ContextExtenderInterceptor(
 				ctx.LoggerProvider(s.logger),
 				ctx.DBProvider(s.db),
+				ctx.DepositAddressesProvider(s.addresses),
 				ctx.ClientsProvider(s.clients),
 				ctx.FetcherProvider(s.processor),
 				ctx.CoreConnectorProvider(s.connector),
@@ -93,6 +93,110 @@ func (x *CheckWithdrawalResponse) GetWithdrawalIdentifier() *types.WithdrawalIde
 	return nil
 }
 
+type DepositAddressRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ChainId            string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	DestinationChainId string                 `protobuf:"bytes,2,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	Receiver           string                 `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DepositAddressRequest) Reset() {
+	*x = DepositAddressRequest{}
+	mi := &file_api_server_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAddressRequest) ProtoMessage() {}
+
+func (x *DepositAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAddressRequest.ProtoReflect.Descriptor instead.
+func (*DepositAddressRequest) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DepositAddressRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *DepositAddressRequest) GetDestinationChainId() string {
+	if x != nil {
+		return x.DestinationChainId
+	}
+	return ""
+}
+
+func (x *DepositAddressRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+type DepositAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositAddressResponse) Reset() {
+	*x = DepositAddressResponse{}
+	mi := &file_api_server_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAddressResponse) ProtoMessage() {}
+
+func (x *DepositAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAddressResponse.ProtoReflect.Descriptor instead.
+func (*DepositAddressResponse) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DepositAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 var File_api_server_proto protoreflect.FileDescriptor
 
 const file_api_server_proto_rawDesc = "" +
@@ -103,10 +207,17 @@ const file_api_server_proto_rawDesc = "" +
 	"\rtransfer_data\x18\x02 \x01(\v2\x15.deposit.TransferDataR\ftransferData\x12F\n" +
 	"\x11withdrawal_status\x18\x03 \x01(\x0e2\x19.deposit.WithdrawalStatusR\x10withdrawalStatus\x12W\n" +
 	"\x15withdrawal_identifier\x18\x04 \x01(\v2\x1d.deposit.WithdrawalIdentifierH\x00R\x14withdrawalIdentifier\x88\x01\x01B\x18\n" +
-	"\x16_withdrawal_identifier2\xde\x01\n" +
+	"\x16_withdrawal_identifier\"\x80\x01\n" +
+	"\x15DepositAddressRequest\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\tR\achainId\x120\n" +
+	"\x14destination_chain_id\x18\x02 \x01(\tR\x12destinationChainId\x12\x1a\n" +
+	"\breceiver\x18\x03 \x01(\tR\breceiver\"2\n" +
+	"\x16DepositAddressResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress2\xf4\x02\n" +
 	"\x03API\x12Z\n" +
 	"\x10SubmitWithdrawal\x12\x1a.deposit.DepositIdentifier\x1a\x16.google.protobuf.Empty\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/submit\x12{\n" +
-	"\x0fCheckWithdrawal\x12\x1a.deposit.DepositIdentifier\x1a\x1c.api.CheckWithdrawalResponse\".\x82\xd3\xe4\x93\x02(\x12&/check/{chain_id}/{tx_hash}/{tx_nonce}B:Z8github.com/Bridgeless-Project/tss-svc/internal/api/typesb\x06proto3"
+	"\x0fCheckWithdrawal\x12\x1a.deposit.DepositIdentifier\x1a\x1c.api.CheckWithdrawalResponse\".\x82\xd3\xe4\x93\x02(\x12&/check/{chain_id}/{tx_hash}/{tx_nonce}\x12\x93\x01\n" +
+	"\x11GetDepositAddress\x12\x1a.api.DepositAddressRequest\x1a\x1b.api.DepositAddressResponse\"E\x82\xd3\xe4\x93\x02?\x12=/deposit-address/{chain_id}/{destination_chain_id}/{receiver}B:Z8github.com/Bridgeless-Project/tss-svc/internal/api/typesb\x06proto3"
 
 var (
 	file_api_server_proto_rawDescOnce sync.Once
@@ -120,26 +231,30 @@ func file_api_server_proto_rawDescGZIP() []byte {
 	return file_api_server_proto_rawDescData
 }
 
-var file_api_server_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_server_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_api_server_proto_goTypes = []any{
 	(*CheckWithdrawalResponse)(nil),    // 0: api.CheckWithdrawalResponse
-	(*types.DepositIdentifier)(nil),    // 1: deposit.DepositIdentifier
-	(*types.TransferData)(nil),         // 2: deposit.TransferData
-	(types.WithdrawalStatus)(0),        // 3: deposit.WithdrawalStatus
-	(*types.WithdrawalIdentifier)(nil), // 4: deposit.WithdrawalIdentifier
-	(*emptypb.Empty)(nil),              // 5: google.protobuf.Empty
+	(*DepositAddressRequest)(nil),      // 1: api.DepositAddressRequest
+	(*DepositAddressResponse)(nil),     // 2: api.DepositAddressResponse
+	(*types.DepositIdentifier)(nil),    // 3: deposit.DepositIdentifier
+	(*types.TransferData)(nil),         // 4: deposit.TransferData
+	(types.WithdrawalStatus)(0),        // 5: deposit.WithdrawalStatus
+	(*types.WithdrawalIdentifier)(nil), // 6: deposit.WithdrawalIdentifier
+	(*emptypb.Empty)(nil),              // 7: google.protobuf.Empty
 }
 var file_api_server_proto_depIdxs = []int32{
-	1, // 0: api.CheckWithdrawalResponse.deposit_identifier:type_name -> deposit.DepositIdentifier
-	2, // 1: api.CheckWithdrawalResponse.transfer_data:type_name -> deposit.TransferData
-	3, // 2: api.CheckWithdrawalResponse.withdrawal_status:type_name -> deposit.WithdrawalStatus
-	4, // 3: api.CheckWithdrawalResponse.withdrawal_identifier:type_name -> deposit.WithdrawalIdentifier
-	1, // 4: api.API.SubmitWithdrawal:input_type -> deposit.DepositIdentifier
-	1, // 5: api.API.CheckWithdrawal:input_type -> deposit.DepositIdentifier
-	5, // 6: api.API.SubmitWithdrawal:output_type -> google.protobuf.Empty
-	0, // 7: api.API.CheckWithdrawal:output_type -> api.CheckWithdrawalResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
+	3, // 0: api.CheckWithdrawalResponse.deposit_identifier:type_name -> deposit.DepositIdentifier
+	4, // 1: api.CheckWithdrawalResponse.transfer_data:type_name -> deposit.TransferData
+	5, // 2: api.CheckWithdrawalResponse.withdrawal_status:type_name -> deposit.WithdrawalStatus
+	6, // 3: api.CheckWithdrawalResponse.withdrawal_identifier:type_name -> deposit.WithdrawalIdentifier
+	3, // 4: api.API.SubmitWithdrawal:input_type -> deposit.DepositIdentifier
+	3, // 5: api.API.CheckWithdrawal:input_type -> deposit.DepositIdentifier
+	1, // 6: api.API.GetDepositAddress:input_type -> api.DepositAddressRequest
+	7, // 7: api.API.SubmitWithdrawal:output_type -> google.protobuf.Empty
+	0, // 8: api.API.CheckWithdrawal:output_type -> api.CheckWithdrawalResponse
+	2, // 9: api.API.GetDepositAddress:output_type -> api.DepositAddressResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
 	4, // [4:4] is the sub-list for extension type_name
 	4, // [4:4] is the sub-list for extension extendee
 	0, // [0:4] is the sub-list for field type_name
@@ -157,7 +272,7 @@ func file_api_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_server_proto_rawDesc), len(file_api_server_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	API_SubmitWithdrawal_FullMethodName = "/api.API/SubmitWithdrawal"
-	API_CheckWithdrawal_FullMethodName  = "/api.API/CheckWithdrawal"
+	API_SubmitWithdrawal_FullMethodName  = "/api.API/SubmitWithdrawal"
+	API_CheckWithdrawal_FullMethodName   = "/api.API/CheckWithdrawal"
+	API_GetDepositAddress_FullMethodName = "/api.API/GetDepositAddress"
 )
 
 // APIClient is the client API for API service.
@@ -31,6 +32,7 @@ const (
 type APIClient interface {
 	SubmitWithdrawal(ctx context.Context, in *types.DepositIdentifier, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CheckWithdrawal(ctx context.Context, in *types.DepositIdentifier, opts ...grpc.CallOption) (*CheckWithdrawalResponse, error)
+	GetDepositAddress(ctx context.Context, in *DepositAddressRequest, opts ...grpc.CallOption) (*DepositAddressResponse, error)
 }
 
 type aPIClient struct {
@@ -61,12 +63,23 @@ func (c *aPIClient) CheckWithdrawal(ctx context.Context, in *types.DepositIdenti
 	return out, nil
 }
 
+func (c *aPIClient) GetDepositAddress(ctx context.Context, in *DepositAddressRequest, opts ...grpc.CallOption) (*DepositAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepositAddressResponse)
+	err := c.cc.Invoke(ctx, API_GetDepositAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations should embed UnimplementedAPIServer
 // for forward compatibility.
 type APIServer interface {
 	SubmitWithdrawal(context.Context, *types.DepositIdentifier) (*emptypb.Empty, error)
 	CheckWithdrawal(context.Context, *types.DepositIdentifier) (*CheckWithdrawalResponse, error)
+	GetDepositAddress(context.Context, *DepositAddressRequest) (*DepositAddressResponse, error)
 }
 
 // UnimplementedAPIServer should be embedded to have
@@ -82,6 +95,9 @@ func (UnimplementedAPIServer) SubmitWithdrawal(context.Context, *types.DepositId
 func (UnimplementedAPIServer) CheckWithdrawal(context.Context, *types.DepositIdentifier) (*CheckWithdrawalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckWithdrawal not implemented")
 }
+func (UnimplementedAPIServer) GetDepositAddress(context.Context, *DepositAddressRequest) (*DepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositAddress not implemented")
+}
 func (UnimplementedAPIServer) testEmbeddedByValue() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -138,6 +154,24 @@ func _API_CheckWithdrawal_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetDepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: API_GetDepositAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetDepositAddress(ctx, req.(*DepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +187,10 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckWithdrawal",
 			Handler:    _API_CheckWithdrawal_Handler,
 		},
+		{
+			MethodName: "GetDepositAddress",
+			Handler:    _API_GetDepositAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api_server.proto",
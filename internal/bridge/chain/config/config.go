@@ -4,12 +4,17 @@ import (
 	"reflect"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/cosmos"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/plugin"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/quorum"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/ton"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/tron"
 	utxochain "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/chain"
 	utxo "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/zano"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/figure/v3"
 	"gitlab.com/distributed_lab/kit/comfig"
@@ -39,26 +44,47 @@ func (c *chainer) Clients() []chain.Client {
 		clients := make([]chain.Client, len(chains))
 
 		for i, ch := range chains {
-			switch ch.Type {
-			case chain.TypeZano:
-				clients[i] = zano.NewBridgeClient(zano.FromChain(ch))
-			case chain.TypeEVM:
-				clients[i] = evm.NewBridgeClient(evm.FromChain(ch))
-			case chain.TypeBitcoin:
-				clients[i] = utxo.NewBridgeClient(utxochain.FromChain(ch))
-			case chain.TypeTON:
-				clients[i] = ton.NewBridgeClient(ton.FromChain(ch))
-			case chain.TypeSolana:
-				clients[i] = solana.NewBridgeClient(solana.FromChain(ch))
-			default:
-				panic(errors.Errorf("unsupported chain type: %s", ch.Type))
+			if !ch.Quorum.Enabled() {
+				clients[i] = newClient(ch)
+				continue
 			}
+
+			providers := []chain.Client{newClient(ch)}
+			for _, rpc := range ch.Quorum.Rpc {
+				provider := ch
+				provider.Rpc = rpc
+				providers = append(providers, newClient(provider))
+			}
+			clients[i] = quorum.NewClient(providers, ch.Quorum.Threshold)
 		}
 
 		return clients
 	}).([]chain.Client)
 }
 
+func newClient(ch chain.Chain) chain.Client {
+	switch ch.Type {
+	case chain.TypeZano:
+		return zano.NewBridgeClient(zano.FromChain(ch))
+	case chain.TypeEVM:
+		return evm.NewBridgeClient(evm.FromChain(ch))
+	case chain.TypeBitcoin:
+		return utxo.NewBridgeClient(utxochain.FromChain(ch))
+	case chain.TypeTON:
+		return ton.NewBridgeClient(ton.FromChain(ch))
+	case chain.TypeSolana:
+		return solana.NewBridgeClient(solana.FromChain(ch))
+	case chain.TypeCosmos:
+		return cosmos.NewBridgeClient(cosmos.FromChain(ch))
+	case chain.TypeTron:
+		return tron.NewBridgeClient(tron.FromChain(ch))
+	case chain.TypePlugin:
+		return plugin.NewBridgeClient(plugin.FromChain(ch))
+	default:
+		panic(errors.Errorf("unsupported chain type: %s", ch.Type))
+	}
+}
+
 func (c *chainer) Chains() []chain.Chain {
 	return c.chainsOnce.Do(func() interface{} {
 		var cfg struct {
@@ -81,6 +107,17 @@ func (c *chainer) Chains() []chain.Chain {
 		if len(cfg.Chains) == 0 {
 			panic(errors.New("no chain were configured"))
 		}
+		for i := range cfg.Chains {
+			if cfg.Chains[i].Curve == "" {
+				cfg.Chains[i].Curve = tss.DefaultCurve
+			}
+			if !cfg.Chains[i].CurveSupported() {
+				panic(errors.Errorf("curve %s is not supported for chain %s", cfg.Chains[i].Curve, cfg.Chains[i].Id))
+			}
+			if err := cfg.Chains[i].Quorum.Validate(); err != nil {
+				panic(errors.Wrapf(err, "invalid quorum for chain %s", cfg.Chains[i].Id))
+			}
+		}
 
 		return cfg.Chains
 	}).([]chain.Chain)
@@ -0,0 +1,85 @@
+package cosmos
+
+import (
+	"crypto/tls"
+	"reflect"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/figure/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Chain struct {
+	Id            string
+	Conn          *grpc.ClientConn
+	BridgeAddress string
+	Confirmations uint64
+
+	Meta Meta
+}
+
+type Meta struct {
+	// NetworkId is the Cosmos chain identifier used in the transactions sign docs, f.e. `cosmoshub-4`
+	NetworkId string `fig:"network_id,required"`
+	// AddressPrefix is the bech32 prefix of the chain account addresses, f.e. `cosmos`
+	AddressPrefix string `fig:"address_prefix,required"`
+	FeeDenom      string `fig:"fee_denom,required"`
+	GasLimit      uint64 `fig:"gas_limit,required"`
+	GasPrice      uint64 `fig:"gas_price,required"`
+}
+
+func FromChain(c chain.Chain) Chain {
+	if c.Type != chain.TypeCosmos {
+		panic("chain is not Cosmos")
+	}
+
+	chain := Chain{
+		Id:            c.Id,
+		Confirmations: c.Confirmations,
+	}
+
+	if err := figure.Out(&chain.Meta).FromInterface(c.Meta).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to decode chain meta"))
+	}
+	if err := figure.Out(&chain.Conn).FromInterface(c.Rpc).With(connHook).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to obtain Cosmos connection"))
+	}
+	if err := figure.Out(&chain.BridgeAddress).FromInterface(c.BridgeAddresses).With(figure.BaseHooks).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to obtain bridge address"))
+	}
+
+	return chain
+}
+
+var connHook = figure.Hooks{
+	"*grpc.ClientConn": func(value interface{}) (reflect.Value, error) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var connConfig struct {
+				Addr      string `fig:"addr,required"`
+				EnableTLS bool   `fig:"enable_tls"`
+			}
+
+			if err := figure.Out(&connConfig).With(figure.BaseHooks).From(v).Please(); err != nil {
+				return reflect.Value{}, errors.Wrap(err, "failed to figure out cosmos rpc config")
+			}
+
+			securityOption := grpc.WithTransportCredentials(insecure.NewCredentials())
+			if connConfig.EnableTLS {
+				securityOption = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS13}))
+			}
+
+			conn, err := grpc.NewClient(connConfig.Addr, securityOption)
+			if err != nil {
+				return reflect.Value{}, errors.Wrap(err, "failed to connect to cosmos node via gRPC")
+			}
+
+			return reflect.ValueOf(conn), nil
+		default:
+			return reflect.Value{}, errors.Errorf("unsupported conversion from %T", value)
+		}
+	},
+}
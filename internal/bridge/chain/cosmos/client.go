@@ -0,0 +1,68 @@
+package cosmos
+
+import (
+	"context"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	txclient "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/pkg/errors"
+)
+
+// accountAddressLengths are the lengths of the plain and module/contract account addresses
+var accountAddressLengths = map[int]struct{}{20: {}, 32: {}}
+
+type Client struct {
+	chain Chain
+
+	transactor txclient.ServiceClient
+	auther     authtypes.QueryClient
+	tendermint tmservice.ServiceClient
+}
+
+func NewBridgeClient(chain Chain) *Client {
+	return &Client{
+		chain:      chain,
+		transactor: txclient.NewServiceClient(chain.Conn),
+		auther:     authtypes.NewQueryClient(chain.Conn),
+		tendermint: tmservice.NewServiceClient(chain.Conn),
+	}
+}
+
+func (c *Client) Chain() Chain {
+	return c.chain
+}
+
+func (c *Client) ChainId() string {
+	return c.chain.Id
+}
+
+func (c *Client) Type() chain.Type {
+	return chain.TypeCosmos
+}
+
+// AddressValid checks the address is the bech32 account address with the chain prefix.
+func (c *Client) AddressValid(addr string) bool {
+	prefix, raw, err := bech32.DecodeAndConvert(addr)
+	if err != nil || prefix != c.chain.Meta.AddressPrefix {
+		return false
+	}
+
+	_, ok := accountAddressLengths[len(raw)]
+	return ok
+}
+
+func (c *Client) TransactionHashValid(hash string) bool {
+	return bridge.DefaultTransactionHashPattern.MatchString(hash)
+}
+
+func (c *Client) HealthCheck() error {
+	if _, err := c.tendermint.GetSyncing(context.Background(), &tmservice.GetSyncingRequest{}); err != nil {
+		return errors.Wrap(err, "failed to get syncing status from cosmos node")
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/pkg/encoding"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txclient "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const referralIdLength = 2
+
+type DepositMemo struct {
+	Address    string
+	ChainId    string
+	ReferralId uint16
+}
+
+// GetDepositData returns the deposit made by the bank MsgSend to the bridge address.
+// The transaction nonce is the index of the message in the transaction,
+// the destination is defined by the transaction memo.
+func (c *Client) GetDepositData(id db.DepositIdentifier) (*db.DepositData, error) {
+	res, err := c.transactor.GetTx(context.Background(), &txclient.GetTxRequest{
+		Hash: strings.TrimPrefix(id.TxHash, bridge.HexPrefix),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, bridgeTypes.ErrTxNotFound
+		}
+
+		return nil, errors.Wrap(err, "failed to get transaction")
+	}
+	if res.TxResponse.Code != 0 {
+		return nil, bridgeTypes.ErrTxFailed
+	}
+	if err = c.validateConfirmations(res.TxResponse.Height); err != nil {
+		return nil, errors.Wrap(err, "failed to validate confirmations")
+	}
+
+	msgs := res.Tx.GetBody().GetMessages()
+	if id.TxNonce < 0 || id.TxNonce >= int64(len(msgs)) {
+		return nil, bridgeTypes.ErrInvalidTxNonce
+	}
+
+	rawMsg := msgs[id.TxNonce]
+	if rawMsg.TypeUrl != sdk.MsgTypeURL(&banktypes.MsgSend{}) {
+		return nil, bridgeTypes.ErrDepositNotFound
+	}
+	var msg banktypes.MsgSend
+	if err = msg.Unmarshal(rawMsg.Value); err != nil {
+		return nil, errors.Wrap(bridgeTypes.ErrInvalidTransactionData, err.Error())
+	}
+	if msg.ToAddress != c.chain.BridgeAddress {
+		return nil, bridgeTypes.ErrDepositNotFound
+	}
+	if len(msg.Amount) != 1 || !msg.Amount[0].Amount.IsPositive() {
+		return nil, bridgeTypes.ErrInvalidDepositedAmount
+	}
+
+	depositMemo, err := decodeDepositMemo(res.Tx.Body.Memo)
+	if err != nil {
+		return nil, errors.Wrap(bridgeTypes.ErrInvalidTransactionMemo, err.Error())
+	}
+
+	return &db.DepositData{
+		DepositIdentifier:  id,
+		DestinationChainId: depositMemo.ChainId,
+		DestinationAddress: depositMemo.Address,
+		ReferralId:         depositMemo.ReferralId,
+		SourceAddress:      msg.FromAddress,
+		DepositAmount:      msg.Amount[0].Amount.BigInt(),
+		TokenAddress:       msg.Amount[0].Denom,
+		Block:              res.TxResponse.Height,
+	}, nil
+}
+
+func (c *Client) validateConfirmations(txHeight int64) error {
+	if txHeight == 0 {
+		return bridgeTypes.ErrTxPending
+	}
+
+	res, err := c.tendermint.GetLatestBlock(context.Background(), &tmservice.GetLatestBlockRequest{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest block")
+	}
+
+	if res.GetBlock().Header.Height-txHeight < int64(c.chain.Confirmations) {
+		return bridgeTypes.ErrTxNotConfirmed
+	}
+
+	return nil
+}
+
+// decodeDepositMemo decodes the hex-encoded deposit memo.
+// deposit memo structure is the same as for the Bitcoin deposits:
+//
+// [lenChainId][chainId][referralId][addressEncodingType][destinationAddress]
+//   - lenChainId: 1 byte, length of chainId
+//   - chainId: variable length
+//   - referralId: 2 bytes, big-endian
+//   - addressEncodingType: 1 byte
+//   - destinationAddress: variable length
+func decodeDepositMemo(memo string) (*DepositMemo, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(memo, bridge.HexPrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode hex memo")
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("empty deposit memo")
+	}
+
+	chainIdLength := int(raw[0])
+	if len(raw) <= 1+chainIdLength+referralIdLength+1 {
+		return nil, errors.New("invalid deposit memo length")
+	}
+	chainIdEndIdx := 1 + chainIdLength
+
+	var depositMemo DepositMemo
+	depositMemo.ChainId = string(raw[1:chainIdEndIdx])
+	depositMemo.ReferralId = binary.BigEndian.Uint16(raw[chainIdEndIdx : chainIdEndIdx+referralIdLength])
+
+	encoder := encoding.GetEncoder(encoding.Type(raw[chainIdEndIdx+referralIdLength]))
+	if encoder == nil {
+		return nil, errors.New("unknown address encoding type")
+	}
+
+	depositMemo.Address = encoder.Encode(raw[chainIdEndIdx+referralIdLength+1:])
+
+	return &depositMemo, nil
+}
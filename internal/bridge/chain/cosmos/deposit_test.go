@@ -0,0 +1,81 @@
+package cosmos
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/Bridgeless-Project/tss-svc/pkg/encoding"
+)
+
+func constructMemo(chainId string, referralId uint16, addrEncodingType byte, rawAddr []byte) string {
+	raw := []byte{byte(len(chainId))}
+	raw = append(raw, chainId...)
+	raw = binary.BigEndian.AppendUint16(raw, referralId)
+	raw = append(raw, addrEncodingType)
+	raw = append(raw, rawAddr...)
+
+	return hex.EncodeToString(raw)
+}
+
+func Test_DecodeDepositMemo(t *testing.T) {
+	rawAddr, _ := hex.DecodeString("beefd475a76ec312502ba7b566a9b4cea91ab030")
+
+	tests := map[string]struct {
+		memo     string
+		expected DepositMemo
+		err      bool
+	}{
+		"valid memo": {
+			memo: constructMemo("35443", 7, byte(encoding.TypeHexCheckSum), rawAddr),
+			expected: DepositMemo{
+				ChainId:    "35443",
+				Address:    "0xbeefD475A76Ec312502ba7B566a9B4CEA91ab030",
+				ReferralId: 7,
+			},
+		},
+		"valid memo (0x-prefixed)": {
+			memo: "0x" + constructMemo("35443", 0, byte(encoding.TypeHex), rawAddr),
+			expected: DepositMemo{
+				ChainId: "35443",
+				Address: "0xbeefd475a76ec312502ba7b566a9b4cea91ab030",
+			},
+		},
+		"invalid memo (not hex)": {
+			memo: "0xbeefD475A76Ec312502ba7B566a9B4CEA91ab030-35443",
+			err:  true,
+		},
+		"invalid memo (empty)": {
+			memo: "",
+			err:  true,
+		},
+		"invalid memo (missing address)": {
+			memo: constructMemo("35443", 0, byte(encoding.TypeHex), nil),
+			err:  true,
+		},
+		"invalid memo (unknown encoding)": {
+			memo: constructMemo("35443", 0, 0xFF, rawAddr),
+			err:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := decodeDepositMemo(tc.memo)
+			if err != nil {
+				if !tc.err {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+			if tc.err {
+				t.Fatal("expected error, got nil")
+			}
+
+			if *parsed != tc.expected {
+				t.Fatalf("expected memo %v, got %v", tc.expected, *parsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package cosmos
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	txclient "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// UnsignedTransaction is the withdrawal transaction signed in the SIGN_MODE_DIRECT
+type UnsignedTransaction struct {
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+	AccountNumber uint64
+}
+
+func (c *Client) WithdrawalAmountValid(amount *big.Int) bool {
+	if amount.Cmp(bridge.ZeroAmount) != 1 {
+		return false
+	}
+
+	return true
+}
+
+// Address returns the chain account address of the TSS public key.
+func (c *Client) Address(pub *ecdsa.PublicKey) (string, error) {
+	key := secp256k1.PubKey{Key: crypto.CompressPubkey(pub)}
+
+	return bech32.ConvertAndEncode(c.chain.Meta.AddressPrefix, key.Address())
+}
+
+// WithdrawalTransaction builds the bank MsgSend transaction from the TSS account to the deposit receiver.
+// The transaction is bound to the current TSS account sequence.
+func (c *Client) WithdrawalTransaction(deposit db.Deposit, pub *ecdsa.PublicKey) (*UnsignedTransaction, error) {
+	sender, err := c.Address(pub)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tss address")
+	}
+	if !c.AddressValid(deposit.Receiver) {
+		return nil, errors.New("invalid receiver address")
+	}
+	amount, ok := math.NewIntFromString(deposit.WithdrawalAmount)
+	if !ok {
+		return nil, errors.New("failed to convert withdrawal amount")
+	}
+
+	account, err := c.account(sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tss account")
+	}
+
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: sender,
+		ToAddress:   deposit.Receiver,
+		Amount:      sdk.NewCoins(sdk.NewCoin(deposit.WithdrawalToken, amount)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack message")
+	}
+	bodyBytes, err := (&txclient.TxBody{Messages: []*codectypes.Any{msg}}).Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal transaction body")
+	}
+
+	pubKey, err := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: crypto.CompressPubkey(pub)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack public key")
+	}
+	authInfo := txclient.AuthInfo{
+		SignerInfos: []*txclient.SignerInfo{{
+			PublicKey: pubKey,
+			ModeInfo: &txclient.ModeInfo{
+				Sum: &txclient.ModeInfo_Single_{Single: &txclient.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}},
+			},
+			Sequence: account.Sequence,
+		}},
+		Fee: &txclient.Fee{
+			Amount: sdk.NewCoins(sdk.NewCoin(
+				c.chain.Meta.FeeDenom,
+				math.NewIntFromUint64(c.chain.Meta.GasLimit).Mul(math.NewIntFromUint64(c.chain.Meta.GasPrice)),
+			)),
+			GasLimit: c.chain.Meta.GasLimit,
+		},
+	}
+	authInfoBytes, err := authInfo.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal auth info")
+	}
+
+	return &UnsignedTransaction{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		AccountNumber: account.AccountNumber,
+	}, nil
+}
+
+// SignHash returns the hash of the transaction sign doc signed by the TSS key.
+func (c *Client) SignHash(tx UnsignedTransaction) ([]byte, error) {
+	signDoc := txclient.SignDoc{
+		BodyBytes:     tx.BodyBytes,
+		AuthInfoBytes: tx.AuthInfoBytes,
+		ChainId:       c.chain.Meta.NetworkId,
+		AccountNumber: tx.AccountNumber,
+	}
+
+	raw, err := signDoc.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal sign doc")
+	}
+	hash := sha256.Sum256(raw)
+
+	return hash[:], nil
+}
+
+// EncodeTransaction returns the raw signed transaction and its hash.
+func EncodeTransaction(tx UnsignedTransaction, signature *common.SignatureData) ([]byte, string, error) {
+	// secp256k1 signatures are expected in the 64-byte R || S form with the low S,
+	// which is guaranteed by the TSS signing
+	sig := make([]byte, 64)
+	new(big.Int).SetBytes(signature.R).FillBytes(sig[:32])
+	new(big.Int).SetBytes(signature.S).FillBytes(sig[32:])
+
+	raw, err := (&txclient.TxRaw{
+		BodyBytes:     tx.BodyBytes,
+		AuthInfoBytes: tx.AuthInfoBytes,
+		Signatures:    [][]byte{sig},
+	}).Marshal()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to marshal signed transaction")
+	}
+	hash := sha256.Sum256(raw)
+
+	return raw, bridge.HexPrefix + fmt.Sprintf("%x", hash), nil
+}
+
+func (c *Client) SendSignedTransaction(raw []byte) error {
+	res, err := c.transactor.BroadcastTx(context.Background(), &txclient.BroadcastTxRequest{
+		Mode:    txclient.BroadcastMode_BROADCAST_MODE_SYNC,
+		TxBytes: raw,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to broadcast transaction")
+	}
+	if res.TxResponse.Code != 0 && !strings.Contains(res.TxResponse.RawLog, "tx already exists") {
+		return errors.Errorf("transaction failed with code %d: %s", res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+
+	return nil
+}
+
+func (c *Client) account(address string) (*authtypes.BaseAccount, error) {
+	res, err := c.auther.Account(context.Background(), &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get account")
+	}
+	if res.Account == nil || res.Account.TypeUrl != "/"+proto.MessageName(&authtypes.BaseAccount{}) {
+		return nil, errors.New("unsupported account type")
+	}
+
+	var account authtypes.BaseAccount
+	if err = account.Unmarshal(res.Account.Value); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal account")
+	}
+
+	return &account, nil
+}
@@ -1,18 +1,65 @@
 package evm
 
 import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/operations"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/indexer"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/figure/v3"
 )
 
+const defaultReceiptTimeout = 5 * time.Minute
+
 type Chain struct {
 	Id            string
 	Rpc           *ethclient.Client
-	BridgeAddress common.Address
+	Contracts     []BridgeContract
 	Confirmations uint64
+
+	Meta Meta
+}
+
+// BridgeContract is one of the bridge contracts running side by side, f.e. during the migration.
+type BridgeContract struct {
+	Address common.Address  `fig:"address,required"`
+	Version ContractVersion `fig:"version,required"`
+	// WithdrawalTokens are the tokens withdrawn via the contract,
+	// the contract with no tokens configured is used for the rest of them
+	WithdrawalTokens []string `fig:"withdrawal_tokens"`
+	// EIP712 is set if the contract verifies the withdrawals signed as the EIP-712 typed data
+	EIP712 EIP712Settings `fig:"eip712"`
+}
+
+type Meta struct {
+	Relayer RelayerSettings  `fig:"relayer"`
+	Indexer indexer.Settings `fig:"indexer"`
+	// Finality defines when the deposits are considered confirmed,
+	// the block depth set by the chain confirmations is used by default
+	Finality FinalitySettings `fig:"finality"`
+}
+
+// EIP712Settings configures the EIP-712 domain of the bridge contract verifying
+// the typed withdrawal signatures, the chain id and contract address complete the domain.
+type EIP712Settings struct {
+	Enabled bool   `fig:"enabled"`
+	Name    string `fig:"name"`
+	Version string `fig:"version"`
+}
+
+type RelayerSettings struct {
+	// Enabled defines whether the signed withdrawals should be sent
+	// to the bridge contract by the session leader
+	Enabled bool `fig:"enabled"`
+	// ReceiptTimeout is the maximum time to wait for the withdrawal transaction receipt
+	ReceiptTimeout time.Duration `fig:"receipt_timeout"`
 }
 
 func FromChain(c chain.Chain) Chain {
@@ -27,9 +74,158 @@ func FromChain(c chain.Chain) Chain {
 	if err := figure.Out(&chain.Rpc).FromInterface(c.Rpc).With(figure.EthereumHooks).Please(); err != nil {
 		panic(errors.Wrap(err, "failed to obtain Ethereum clients"))
 	}
-	if err := figure.Out(&chain.BridgeAddress).FromInterface(c.BridgeAddresses).With(figure.EthereumHooks).Please(); err != nil {
+	contracts, err := decodeContracts(c.BridgeAddresses)
+	if err != nil {
 		panic(errors.Wrap(err, "failed to obtain bridge addresses"))
 	}
+	chain.Contracts = contracts
+	if err := figure.Out(&chain.Meta).FromInterface(c.Meta).With(figure.EthereumHooks).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to decode chain meta"))
+	}
+	if chain.Meta.Finality.Policy == "" {
+		chain.Meta.Finality.Policy = FinalityDepth
+	}
+	if err := chain.Meta.Finality.Validate(); err != nil {
+		panic(errors.Wrap(err, "invalid finality settings"))
+	}
+	if chain.Meta.Relayer.ReceiptTimeout == 0 {
+		chain.Meta.Relayer.ReceiptTimeout = defaultReceiptTimeout
+	}
+	for _, contract := range chain.Contracts {
+		if _, ok := new(big.Int).SetString(chain.Id, 10); contract.EIP712.Enabled && !ok {
+			panic(errors.New("EIP-712 domain requires the numeric chain id"))
+		}
+	}
 
 	return chain
 }
+
+// decodeContracts supports both the plain bridge address and the list of the versioned contracts.
+func decodeContracts(raw any) ([]BridgeContract, error) {
+	if _, ok := raw.(string); ok {
+		var address common.Address
+		if err := figure.Out(&address).FromInterface(raw).With(figure.EthereumHooks).Please(); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bridge address")
+		}
+
+		return []BridgeContract{{Address: address, Version: ContractVersionAny}}, nil
+	}
+
+	var contracts []BridgeContract
+	if err := figure.Out(&contracts).FromInterface(raw).With(figure.EthereumHooks).Please(); err != nil {
+		return nil, errors.Wrap(err, "failed to decode bridge contracts")
+	}
+	if err := validateContracts(contracts); err != nil {
+		return nil, errors.Wrap(err, "invalid bridge contracts")
+	}
+
+	for i := range contracts {
+		for j, token := range contracts[i].WithdrawalTokens {
+			contracts[i].WithdrawalTokens[j] = strings.ToLower(token)
+		}
+	}
+
+	return contracts, nil
+}
+
+func validateContracts(contracts []BridgeContract) error {
+	if len(contracts) == 0 {
+		return errors.New("no contracts configured")
+	}
+
+	var (
+		defaults  int
+		addresses = make(map[common.Address]struct{}, len(contracts))
+		tokens    = make(map[string]struct{})
+	)
+	for _, contract := range contracts {
+		if err := contract.Version.Validate(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid contract %s", contract.Address))
+		}
+		if _, ok := addresses[contract.Address]; ok {
+			return errors.New(fmt.Sprintf("duplicated contract %s", contract.Address))
+		}
+		addresses[contract.Address] = struct{}{}
+
+		if contract.EIP712.Enabled {
+			// the legacy contract verifies the personal_sign withdrawal signatures only
+			if contract.Version == ContractVersionV1 {
+				return errors.New(fmt.Sprintf("EIP-712 is not supported by the v1 contract %s", contract.Address))
+			}
+			if contract.EIP712.Name == "" || contract.EIP712.Version == "" {
+				return errors.New(fmt.Sprintf("EIP-712 domain name and version are required for contract %s", contract.Address))
+			}
+		}
+
+		if len(contract.WithdrawalTokens) == 0 {
+			defaults++
+		}
+		for _, token := range contract.WithdrawalTokens {
+			if !common.IsHexAddress(token) {
+				return errors.New(fmt.Sprintf("invalid withdrawal token %s", token))
+			}
+			if _, ok := tokens[strings.ToLower(token)]; ok {
+				return errors.New(fmt.Sprintf("withdrawal token %s is configured for multiple contracts", token))
+			}
+			tokens[strings.ToLower(token)] = struct{}{}
+		}
+	}
+	if defaults != 1 {
+		return errors.New(fmt.Sprintf("exactly one contract without withdrawal tokens expected, got %d", defaults))
+	}
+
+	return nil
+}
+
+// Contract returns the bridge contract deployed at the address.
+func (c Chain) Contract(address common.Address) (BridgeContract, bool) {
+	for _, contract := range c.Contracts {
+		if contract.Address == address {
+			return contract, true
+		}
+	}
+
+	return BridgeContract{}, false
+}
+
+// WithdrawalContract returns the bridge contract the token is withdrawn via.
+func (c Chain) WithdrawalContract(token string) BridgeContract {
+	var fallback BridgeContract
+	for _, contract := range c.Contracts {
+		if len(contract.WithdrawalTokens) == 0 {
+			fallback = contract
+			continue
+		}
+		if slices.Contains(contract.WithdrawalTokens, strings.ToLower(token)) {
+			return contract
+		}
+	}
+
+	return fallback
+}
+
+func (c Chain) ContractAddresses() []common.Address {
+	addresses := make([]common.Address, len(c.Contracts))
+	for i, contract := range c.Contracts {
+		addresses[i] = contract.Address
+	}
+
+	return addresses
+}
+
+// WithdrawalDomain returns the EIP-712 domain of the contract, nil if the typed signatures are disabled for it.
+func (c Chain) WithdrawalDomain(contract BridgeContract) *operations.Domain {
+	if !contract.EIP712.Enabled {
+		return nil
+	}
+
+	// the numeric chain id is validated on the chain creation
+	chainId, _ := new(big.Int).SetString(c.Id, 10)
+
+	return &operations.Domain{
+		Name:              contract.EIP712.Name,
+		Version:           contract.EIP712.Version,
+		ChainId:           chainId,
+		VerifyingContract: contract.Address,
+	}
+}
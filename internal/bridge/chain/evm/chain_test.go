@@ -0,0 +1,155 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	oldBridge = "0x00000000000000000000000000000000000000a1"
+	newBridge = "0x00000000000000000000000000000000000000a2"
+	token     = "0x00000000000000000000000000000000000000bB"
+)
+
+func Test_DecodeContracts(t *testing.T) {
+	tests := map[string]struct {
+		raw      any
+		expected int
+		err      bool
+	}{
+		"plain address": {
+			raw:      oldBridge,
+			expected: 1,
+		},
+		"versioned contracts": {
+			raw: []interface{}{
+				map[string]interface{}{"address": oldBridge, "version": "v1"},
+				map[string]interface{}{"address": newBridge, "version": "v2", "withdrawal_tokens": []interface{}{token}},
+			},
+			expected: 2,
+		},
+		"invalid version": {
+			raw: []interface{}{
+				map[string]interface{}{"address": oldBridge, "version": "v3"},
+			},
+			err: true,
+		},
+		"missing version": {
+			raw: []interface{}{
+				map[string]interface{}{"address": oldBridge},
+			},
+			err: true,
+		},
+		"no default contract": {
+			raw: []interface{}{
+				map[string]interface{}{"address": newBridge, "version": "v2", "withdrawal_tokens": []interface{}{token}},
+			},
+			err: true,
+		},
+		"multiple default contracts": {
+			raw: []interface{}{
+				map[string]interface{}{"address": oldBridge, "version": "v1"},
+				map[string]interface{}{"address": newBridge, "version": "v2"},
+			},
+			err: true,
+		},
+		"eip712 contract": {
+			raw: []interface{}{
+				map[string]interface{}{"address": oldBridge, "version": "v1"},
+				map[string]interface{}{
+					"address":           newBridge,
+					"version":           "v2",
+					"withdrawal_tokens": []interface{}{token},
+					"eip712":            map[string]interface{}{"enabled": true, "name": "Bridge", "version": "1"},
+				},
+			},
+			expected: 2,
+		},
+		"eip712 legacy contract": {
+			raw: []interface{}{
+				map[string]interface{}{
+					"address": oldBridge,
+					"version": "v1",
+					"eip712":  map[string]interface{}{"enabled": true, "name": "Bridge", "version": "1"},
+				},
+			},
+			err: true,
+		},
+		"eip712 without domain name": {
+			raw: []interface{}{
+				map[string]interface{}{
+					"address": newBridge,
+					"version": "v2",
+					"eip712":  map[string]interface{}{"enabled": true, "version": "1"},
+				},
+			},
+			err: true,
+		},
+		"token configured twice": {
+			raw: []interface{}{
+				map[string]interface{}{"address": oldBridge, "version": "v1", "withdrawal_tokens": []interface{}{token}},
+				map[string]interface{}{"address": newBridge, "version": "v2", "withdrawal_tokens": []interface{}{token}},
+			},
+			err: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			contracts, err := decodeContracts(tc.raw)
+			if err != nil {
+				if !tc.err {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+			if tc.err {
+				t.Fatal("expected error, got nil")
+			}
+
+			if len(contracts) != tc.expected {
+				t.Fatalf("expected %d contracts, got %d", tc.expected, len(contracts))
+			}
+		})
+	}
+}
+
+func Test_WithdrawalContract(t *testing.T) {
+	contracts, err := decodeContracts([]interface{}{
+		map[string]interface{}{"address": oldBridge, "version": "v1"},
+		map[string]interface{}{"address": newBridge, "version": "v2", "withdrawal_tokens": []interface{}{token}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	chain := Chain{Contracts: contracts}
+
+	tests := map[string]struct {
+		token    string
+		expected string
+	}{
+		"configured token":            {token: token, expected: newBridge},
+		"configured token (checksum)": {token: common.HexToAddress(token).Hex(), expected: newBridge},
+		"native token":                {token: bridge.DefaultNativeTokenAddress, expected: oldBridge},
+		"unknown token":               {token: "0x00000000000000000000000000000000000000cc", expected: oldBridge},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			contract := chain.WithdrawalContract(tc.token)
+			if contract.Address != common.HexToAddress(tc.expected) {
+				t.Fatalf("expected contract %s, got %s", tc.expected, contract.Address)
+			}
+		})
+	}
+
+	if _, ok := chain.Contract(common.HexToAddress(newBridge)); !ok {
+		t.Fatal("expected the new bridge contract to be found")
+	}
+	if _, ok := chain.Contract(common.HexToAddress(token)); ok {
+		t.Fatal("expected the token not to be a bridge contract")
+	}
+}
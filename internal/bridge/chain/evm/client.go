@@ -24,6 +24,8 @@ type Client struct {
 	abiV1           abi.ABI
 	abiV2           abi.ABI
 	supportedEvents map[string]EventType
+	// nodeInterfaceAbi is used to check the Arbitrum batches finality
+	nodeInterfaceAbi abi.ABI
 }
 
 // NewBridgeClient creates a new bridge Client for the given chain.
@@ -53,10 +55,11 @@ func NewBridgeClient(chain Chain) *Client {
 	}
 
 	return &Client{
-		chain:           chain,
-		abiV1:           abiV1,
-		abiV2:           abiV2,
-		supportedEvents: supportedEvents,
+		chain:            chain,
+		abiV1:            abiV1,
+		abiV2:            abiV2,
+		supportedEvents:  supportedEvents,
+		nodeInterfaceAbi: mustNodeInterfaceAbi(),
 	}
 }
 
@@ -64,6 +67,10 @@ func (p *Client) ChainId() string {
 	return p.chain.Id
 }
 
+func (p *Client) Chain() Chain {
+	return p.chain
+}
+
 func (p *Client) Type() chain.Type {
 	return chain.TypeEVM
 }
@@ -30,7 +30,8 @@ func (p *Client) GetDepositData(id db.DepositIdentifier) (*db.DepositData, error
 	}
 
 	log := txReceipt.Logs[id.TxNonce]
-	if log.Address.Hex() != p.chain.BridgeAddress.Hex() {
+	contract, ok := p.chain.Contract(log.Address)
+	if !ok {
 		return nil, bridgeTypes.ErrUnsupportedContract
 	}
 
@@ -38,8 +39,11 @@ func (p *Client) GetDepositData(id db.DepositIdentifier) (*db.DepositData, error
 	if eventType == "" {
 		return nil, bridgeTypes.ErrDepositNotFound
 	}
+	if !contract.Version.Emits(eventType) {
+		return nil, bridgeTypes.ErrUnsupportedEvent
+	}
 
-	if err = p.validateConfirmations(txReceipt); err != nil {
+	if err = p.validateConfirmations(context.Background(), txReceipt); err != nil {
 		return nil, errors.Wrap(err, "failed to validate confirmations")
 	}
 
@@ -111,17 +115,3 @@ func (p *Client) GetDepositData(id db.DepositIdentifier) (*db.DepositData, error
 
 	return unpackedData, nil
 }
-
-func (p *Client) validateConfirmations(receipt *types.Receipt) error {
-	curHeight, err := p.chain.Rpc.BlockNumber(context.Background())
-	if err != nil {
-		return errors.Wrap(err, "failed to get current block number")
-	}
-
-	// including the current block
-	if receipt.BlockNumber.Uint64()+p.chain.Confirmations-1 > curHeight {
-		return bridgeTypes.ErrTxNotConfirmed
-	}
-
-	return nil
-}
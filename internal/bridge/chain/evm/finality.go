@@ -0,0 +1,176 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// FinalityPolicy defines when the block containing the deposit is considered final.
+type FinalityPolicy string
+
+const (
+	// FinalityDepth requires the configured number of confirmations including the deposit block
+	FinalityDepth FinalityPolicy = "depth"
+	// FinalitySafe requires the deposit block to be at or below the `safe` block
+	FinalitySafe FinalityPolicy = "safe"
+	// FinalityFinalized requires the deposit block to be at or below the `finalized` block
+	FinalityFinalized FinalityPolicy = "finalized"
+	// FinalityOpStack requires the deposit block to be derived from the finalized L1 data,
+	// the finalized L2 head is obtained from the OP-stack rollup node
+	FinalityOpStack FinalityPolicy = "op_stack"
+	// FinalityArbitrum requires the batch containing the deposit block
+	// to have the configured number of L1 confirmations
+	FinalityArbitrum FinalityPolicy = "arbitrum"
+)
+
+// arbitrumNodeInterface is the Arbitrum Nitro virtual contract available via eth_call only.
+var arbitrumNodeInterface = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+
+const (
+	arbitrumNodeInterfaceAbi = `[{"inputs":[{"internalType":"bytes32","name":"blockHash","type":"bytes32"}],"name":"getL1Confirmations","outputs":[{"internalType":"uint64","name":"confirmations","type":"uint64"}],"stateMutability":"view","type":"function"}]`
+	methodGetL1Confirmations = "getL1Confirmations"
+	methodOptimismSyncStatus = "optimism_syncStatus"
+)
+
+type FinalitySettings struct {
+	Policy FinalityPolicy `fig:"policy"`
+	// RollupRpc is the OP-stack rollup node (op-node) endpoint, required by the op_stack policy
+	RollupRpc *ethclient.Client `fig:"rollup_rpc"`
+	// L1Confirmations is the number of L1 confirmations of the batch, required by the arbitrum policy
+	L1Confirmations uint64 `fig:"l1_confirmations"`
+}
+
+func (s FinalitySettings) Validate() error {
+	switch s.Policy {
+	case FinalityDepth, FinalitySafe, FinalityFinalized:
+		return nil
+	case FinalityOpStack:
+		if s.RollupRpc == nil {
+			return errors.New("rollup node endpoint is required by the op_stack policy")
+		}
+		return nil
+	case FinalityArbitrum:
+		if s.L1Confirmations == 0 {
+			return errors.New("L1 confirmations are required by the arbitrum policy")
+		}
+		return nil
+	default:
+		return errors.New(fmt.Sprintf("invalid finality policy %q", s.Policy))
+	}
+}
+
+// opSyncStatus is the subset of the op-node sync status response.
+type opSyncStatus struct {
+	FinalizedL2 struct {
+		Number uint64 `json:"number"`
+	} `json:"finalized_l2"`
+}
+
+// confirmedHeight returns the latest block satisfying the chain finality policy.
+// The Arbitrum batches are checked per block, so the `finalized` block is used
+// as the conservative bound for the block ranges.
+func (p *Client) confirmedHeight(ctx context.Context) (uint64, error) {
+	switch p.chain.Meta.Finality.Policy {
+	case FinalitySafe:
+		return p.taggedHeight(ctx, rpc.SafeBlockNumber)
+	case FinalityFinalized, FinalityArbitrum:
+		return p.taggedHeight(ctx, rpc.FinalizedBlockNumber)
+	case FinalityOpStack:
+		var status opSyncStatus
+		if err := p.chain.Meta.Finality.RollupRpc.Client().CallContext(ctx, &status, methodOptimismSyncStatus); err != nil {
+			return 0, errors.Wrap(err, "failed to get rollup sync status")
+		}
+		return status.FinalizedL2.Number, nil
+	case FinalityDepth:
+		curHeight, err := p.chain.Rpc.BlockNumber(ctx)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get current block number")
+		}
+
+		// including the current block
+		if p.chain.Confirmations > 1 {
+			if curHeight < p.chain.Confirmations-1 {
+				return 0, nil
+			}
+			curHeight -= p.chain.Confirmations - 1
+		}
+
+		return curHeight, nil
+	default:
+		return 0, errors.New(fmt.Sprintf("unknown finality policy %q", p.chain.Meta.Finality.Policy))
+	}
+}
+
+func (p *Client) taggedHeight(ctx context.Context, tag rpc.BlockNumber) (uint64, error) {
+	header, err := p.chain.Rpc.HeaderByNumber(ctx, big.NewInt(tag.Int64()))
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("failed to get %s block header", tag))
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+func (p *Client) validateConfirmations(ctx context.Context, receipt *types.Receipt) error {
+	if p.chain.Meta.Finality.Policy == FinalityArbitrum {
+		confirmations, err := p.l1Confirmations(ctx, receipt.BlockHash)
+		if err != nil {
+			return errors.Wrap(err, "failed to get L1 confirmations")
+		}
+		if confirmations < p.chain.Meta.Finality.L1Confirmations {
+			return bridgeTypes.ErrTxNotConfirmed
+		}
+
+		return nil
+	}
+
+	height, err := p.confirmedHeight(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get confirmed height")
+	}
+	if receipt.BlockNumber.Uint64() > height {
+		return bridgeTypes.ErrTxNotConfirmed
+	}
+
+	return nil
+}
+
+// l1Confirmations returns the number of L1 confirmations of the Arbitrum batch containing the block,
+// zero is returned until the batch is posted.
+func (p *Client) l1Confirmations(ctx context.Context, blockHash common.Hash) (uint64, error) {
+	data, err := p.nodeInterfaceAbi.Pack(methodGetL1Confirmations, blockHash)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to pack call data")
+	}
+
+	res, err := p.chain.Rpc.CallContract(ctx, ethereum.CallMsg{To: &arbitrumNodeInterface, Data: data}, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to call node interface")
+	}
+
+	var confirmations uint64
+	if err = p.nodeInterfaceAbi.UnpackIntoInterface(&confirmations, methodGetL1Confirmations, res); err != nil {
+		return 0, errors.Wrap(err, "failed to unpack call result")
+	}
+
+	return confirmations, nil
+}
+
+func mustNodeInterfaceAbi() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(arbitrumNodeInterfaceAbi))
+	if err != nil {
+		panic(errors.Wrap(err, "failed to parse node interface ABI"))
+	}
+
+	return parsed
+}
@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// fakeRollup serves the optimism namespace calls of the OP-stack rollup node.
+type fakeRollup struct {
+	finalized uint64
+}
+
+func (f *fakeRollup) SyncStatus() opSyncStatus {
+	var status opSyncStatus
+	status.FinalizedL2.Number = f.finalized
+
+	return status
+}
+
+func newFinalityClient(t *testing.T, policy FinalityPolicy, confirmations uint64, eth *fakeEth) *Client {
+	eth.tagged = map[string]uint64{"safe": 95, "finalized": 90}
+
+	return NewBridgeClient(Chain{
+		Rpc:           newTestRpc(t, "eth", eth),
+		Confirmations: confirmations,
+		Meta: Meta{Finality: FinalitySettings{
+			Policy:          policy,
+			RollupRpc:       newTestRpc(t, "optimism", &fakeRollup{finalized: 80}),
+			L1Confirmations: 64,
+		}},
+	})
+}
+
+func Test_ConfirmedHeight(t *testing.T) {
+	tests := map[string]struct {
+		policy        FinalityPolicy
+		confirmations uint64
+		blockNumber   uint64
+		expected      uint64
+		err           bool
+	}{
+		"depth without confirmations":      {policy: FinalityDepth, blockNumber: 100, expected: 100},
+		"depth of the current block":       {policy: FinalityDepth, confirmations: 1, blockNumber: 100, expected: 100},
+		"depth of multiple blocks":         {policy: FinalityDepth, confirmations: 10, blockNumber: 100, expected: 91},
+		"depth equal to the chain length":  {policy: FinalityDepth, confirmations: 10, blockNumber: 9, expected: 0},
+		"depth above the chain length":     {policy: FinalityDepth, confirmations: 10, blockNumber: 8, expected: 0},
+		"depth of the genesis block":       {policy: FinalityDepth, confirmations: 10, blockNumber: 0, expected: 0},
+		"safe block":                       {policy: FinalitySafe, confirmations: 10, blockNumber: 100, expected: 95},
+		"finalized block":                  {policy: FinalityFinalized, confirmations: 10, blockNumber: 100, expected: 90},
+		"op-stack finalized block":         {policy: FinalityOpStack, blockNumber: 100, expected: 80},
+		"arbitrum ranges by finalized tag": {policy: FinalityArbitrum, blockNumber: 100, expected: 90},
+		"unknown policy":                   {policy: "latest", blockNumber: 100, err: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newFinalityClient(t, tc.policy, tc.confirmations, &fakeEth{blockNumber: tc.blockNumber})
+
+			height, err := client.confirmedHeight(context.Background())
+			if err != nil {
+				if !tc.err {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+			if tc.err {
+				t.Fatal("expected error, got nil")
+			}
+
+			if height != tc.expected {
+				t.Fatalf("expected height %d, got %d", tc.expected, height)
+			}
+		})
+	}
+}
+
+func Test_ValidateConfirmations(t *testing.T) {
+	tests := map[string]struct {
+		policy          FinalityPolicy
+		confirmations   uint64
+		blockNumber     uint64
+		l1Confirmations uint64
+		depositBlock    int64
+		err             error
+		// failed is set if the check fails with an error other than the not confirmed one
+		failed bool
+	}{
+		"depth reached":                      {policy: FinalityDepth, confirmations: 10, blockNumber: 100, depositBlock: 91},
+		"depth not reached":                  {policy: FinalityDepth, confirmations: 10, blockNumber: 100, depositBlock: 92, err: bridgeTypes.ErrTxNotConfirmed},
+		"depth above the chain length":       {policy: FinalityDepth, confirmations: 10, blockNumber: 5, depositBlock: 1, err: bridgeTypes.ErrTxNotConfirmed},
+		"finalized block":                    {policy: FinalityFinalized, blockNumber: 100, depositBlock: 90},
+		"above finalized block":              {policy: FinalityFinalized, blockNumber: 100, depositBlock: 91, err: bridgeTypes.ErrTxNotConfirmed},
+		"arbitrum batch confirmed on L1":     {policy: FinalityArbitrum, blockNumber: 100, l1Confirmations: 64, depositBlock: 99},
+		"arbitrum batch not confirmed on L1": {policy: FinalityArbitrum, blockNumber: 100, l1Confirmations: 63, depositBlock: 10, err: bridgeTypes.ErrTxNotConfirmed},
+		"unknown policy":                     {policy: "latest", blockNumber: 100, depositBlock: 10, failed: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			eth := &fakeEth{blockNumber: tc.blockNumber, l1Confirmations: tc.l1Confirmations}
+			client := newFinalityClient(t, tc.policy, tc.confirmations, eth)
+
+			err := client.validateConfirmations(context.Background(), &types.Receipt{BlockNumber: big.NewInt(tc.depositBlock)})
+			if tc.failed {
+				if err == nil || errors.Is(err, bridgeTypes.ErrTxNotConfirmed) {
+					t.Fatalf("expected check failure, got %v", err)
+				}
+
+				return
+			}
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
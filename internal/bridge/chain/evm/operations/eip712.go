@@ -0,0 +1,68 @@
+package operations
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	domainTypeHash = crypto.Keccak256(
+		[]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"),
+	)
+	withdrawNativeTypeHash = crypto.Keccak256(
+		[]byte("WithdrawNative(uint256 amount,address receiver,bytes32 txHash,uint256 txNonce,uint256 chainId)"),
+	)
+	withdrawERC20TypeHash = crypto.Keccak256(
+		[]byte("WithdrawERC20(address token,uint256 amount,address receiver,bytes32 txHash,uint256 txNonce,uint256 chainId,bool isWrapped)"),
+	)
+)
+
+// Domain is the EIP-712 domain of the bridge contract, binds the signatures
+// to the specific contract deployment.
+type Domain struct {
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+}
+
+func (d Domain) Separator() []byte {
+	return crypto.Keccak256(
+		domainTypeHash,
+		crypto.Keccak256([]byte(d.Name)),
+		crypto.Keccak256([]byte(d.Version)),
+		ToBytes32(d.ChainId.Bytes()),
+		ToBytes32(d.VerifyingContract.Bytes()),
+	)
+}
+
+// TypedDataHash forms the EIP-712 hash of the struct to be signed within the domain.
+func TypedDataHash(domain Domain, structHash []byte) []byte {
+	return crypto.Keccak256([]byte{0x19, 0x01}, domain.Separator(), structHash)
+}
+
+func (w WithdrawNativeContent) StructHash() []byte {
+	return crypto.Keccak256(
+		withdrawNativeTypeHash,
+		w.Amount,
+		ToBytes32(w.Receiver),
+		w.TxHash,
+		w.TxNonce,
+		w.ChainID,
+	)
+}
+
+func (w WithdrawERC20Content) StructHash() []byte {
+	return crypto.Keccak256(
+		withdrawERC20TypeHash,
+		ToBytes32(w.DestinationTokenAddress),
+		w.Amount,
+		ToBytes32(w.Receiver),
+		w.TxHash,
+		w.TxNonce,
+		w.ChainID,
+		ToBytes32(w.IsWrapped),
+	)
+}
@@ -0,0 +1,141 @@
+package operations
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var testDomain = Domain{
+	Name:              "Bridge",
+	Version:           "1",
+	ChainId:           big.NewInt(35443),
+	VerifyingContract: common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678"),
+}
+
+// expectedTypedDataHash calculates the hash with the go-ethereum typed data signer implementation.
+func expectedTypedDataHash(t *testing.T, primaryType string, message apitypes.TypedDataMessage) []byte {
+	domainType := []apitypes.Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	}
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": domainType,
+			"WithdrawNative": {
+				{Name: "amount", Type: "uint256"},
+				{Name: "receiver", Type: "address"},
+				{Name: "txHash", Type: "bytes32"},
+				{Name: "txNonce", Type: "uint256"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"WithdrawERC20": {
+				{Name: "token", Type: "address"},
+				{Name: "amount", Type: "uint256"},
+				{Name: "receiver", Type: "address"},
+				{Name: "txHash", Type: "bytes32"},
+				{Name: "txNonce", Type: "uint256"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "isWrapped", Type: "bool"},
+			},
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              testDomain.Name,
+			Version:           testDomain.Version,
+			ChainId:           (*math.HexOrDecimal256)(testDomain.ChainId),
+			VerifyingContract: testDomain.VerifyingContract.Hex(),
+		},
+		Message: message,
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		t.Fatalf("failed to hash typed data: %v", err)
+	}
+
+	return hash
+}
+
+func Test_TypedDataHash(t *testing.T) {
+	deposit := db.Deposit{
+		DepositIdentifier: db.DepositIdentifier{
+			TxHash:  "0x6f6b9d1bdc24a0b9f1f1b1cb5b3e1b4b0b57c1f7cf9e0e5de35d1c5c8d2c1a11",
+			TxNonce: 3,
+		},
+		Receiver:          "0xbeefd475a76ec312502ba7b566a9b4cea91ab030",
+		WithdrawalChainId: "35443",
+		WithdrawalAmount:  "1000000000000000000",
+	}
+	message := apitypes.TypedDataMessage{
+		"amount":   "1000000000000000000",
+		"receiver": deposit.Receiver,
+		"txHash":   deposit.TxHash,
+		"txNonce":  "3",
+		"chainId":  "35443",
+	}
+
+	tests := map[string]struct {
+		token       string
+		wrapped     bool
+		primaryType string
+	}{
+		"native": {
+			token:       bridge.DefaultNativeTokenAddress,
+			primaryType: "WithdrawNative",
+		},
+		"erc20": {
+			token:       "0x00000000000000000000000000000000000000aa",
+			primaryType: "WithdrawERC20",
+		},
+		"wrapped erc20": {
+			token:       "0x00000000000000000000000000000000000000aa",
+			wrapped:     true,
+			primaryType: "WithdrawERC20",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := deposit
+			data.WithdrawalToken = tc.token
+			data.IsWrappedToken = tc.wrapped
+
+			msg := make(apitypes.TypedDataMessage, len(message))
+			for k, v := range message {
+				msg[k] = v
+			}
+
+			var structHash []byte
+			if tc.primaryType == "WithdrawNative" {
+				content, err := NewWithdrawNativeContent(data)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				structHash = content.StructHash()
+			} else {
+				content, err := NewWithdrawERC20Content(data)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				structHash = content.StructHash()
+
+				msg["token"] = tc.token
+				msg["isWrapped"] = tc.wrapped
+			}
+
+			expected := expectedTypedDataHash(t, tc.primaryType, msg)
+			if got := TypedDataHash(testDomain, structHash); !bytes.Equal(got, expected) {
+				t.Fatalf("expected hash %x, got %x", expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	v1 "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/contracts/v1"
+	v2 "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/contracts/v2"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/operations"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// withdrawer is implemented by the transactors of all the bridge contract versions.
+type withdrawer interface {
+	WithdrawNative(opts *bind.TransactOpts, amount *big.Int, receiver common.Address, txHash [32]byte, txNonce *big.Int, signatures [][]byte) (*types.Transaction, error)
+	WithdrawERC20(opts *bind.TransactOpts, token common.Address, amount *big.Int, receiver common.Address, txHash [32]byte, txNonce *big.Int, isWrapped bool, signatures [][]byte) (*types.Transaction, error)
+}
+
+// Relayer sends the signed withdrawals to the bridge contracts from the configured hot wallet.
+type Relayer struct {
+	chain   Chain
+	key     *ecdsa.PrivateKey
+	from    common.Address
+	bridges map[common.Address]withdrawer
+
+	mu sync.Mutex
+	// nonce is the next nonce to be used by the relayer,
+	// nil if it should be re-fetched from the node
+	nonce *uint64
+}
+
+func NewRelayer(chain Chain, rawKey string) (*Relayer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(rawKey, bridge.HexPrefix))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse relayer private key")
+	}
+
+	bridges := make(map[common.Address]withdrawer, len(chain.Contracts))
+	for _, contract := range chain.Contracts {
+		var transactor withdrawer
+		if contract.Version == ContractVersionV1 {
+			transactor, err = v1.NewBridgeTransactor(contract.Address, chain.Rpc)
+		} else {
+			transactor, err = v2.NewBridgeTransactor(contract.Address, chain.Rpc)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to create bridge transactor for %s", contract.Address))
+		}
+		bridges[contract.Address] = transactor
+	}
+
+	return &Relayer{
+		chain:   chain,
+		key:     key,
+		from:    crypto.PubkeyToAddress(key.PublicKey),
+		bridges: bridges,
+	}, nil
+}
+
+func (r *Relayer) Address() common.Address {
+	return r.from
+}
+
+// Relay sends the withdrawal transaction for the given deposit signed with the provided TSS signature.
+// Gas limit and fees are estimated by the node, nonce is managed locally.
+func (r *Relayer) Relay(ctx context.Context, deposit db.Deposit, signature []byte) (*types.Transaction, error) {
+	chainId, err := r.chain.Rpc.ChainID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get chain id")
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(r.key, chainId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create transactor")
+	}
+	opts.Context = ctx
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	nonce, err := r.nextNonce(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get relayer nonce")
+	}
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+
+	tx, err := r.sendWithdrawal(opts, deposit, signature)
+	if err != nil {
+		// local nonce can be out of sync with the node, re-fetching it next time
+		r.nonce = nil
+		return nil, errors.Wrap(err, "failed to send withdrawal transaction")
+	}
+
+	nonce++
+	r.nonce = &nonce
+
+	return tx, nil
+}
+
+// WaitForReceipt waits until the withdrawal transaction is mined
+// and ensures it was executed successfully.
+func (r *Relayer) WaitForReceipt(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.chain.Meta.Relayer.ReceiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(ctx, r.chain.Rpc, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wait for transaction to be mined")
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, bridgeTypes.ErrTxFailed
+	}
+
+	return receipt, nil
+}
+
+func (r *Relayer) nextNonce(ctx context.Context) (uint64, error) {
+	pending, err := r.chain.Rpc.PendingNonceAt(ctx, r.from)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get pending nonce")
+	}
+
+	// transactions sent recently may not be visible in the node mempool yet
+	if r.nonce != nil && *r.nonce > pending {
+		return *r.nonce, nil
+	}
+
+	return pending, nil
+}
+
+func (r *Relayer) sendWithdrawal(opts *bind.TransactOpts, deposit db.Deposit, signature []byte) (*types.Transaction, error) {
+	amount, ok := new(big.Int).SetString(deposit.WithdrawalAmount, 10)
+	if !ok {
+		return nil, errors.New("invalid withdrawal amount")
+	}
+	if !common.IsHexAddress(deposit.Receiver) {
+		return nil, errors.New("invalid receiver address")
+	}
+
+	// the withdrawal is signed for the contract the token is configured for
+	contract := r.bridges[r.chain.WithdrawalContract(deposit.WithdrawalToken).Address]
+
+	var (
+		receiver   = common.HexToAddress(deposit.Receiver)
+		txHash     = [32]byte(operations.TxHashToBytes32(deposit.TxHash))
+		txNonce    = big.NewInt(deposit.TxNonce)
+		signatures = [][]byte{signature}
+	)
+
+	if deposit.WithdrawalToken == bridge.DefaultNativeTokenAddress {
+		return contract.WithdrawNative(opts, amount, receiver, txHash, txNonce, signatures)
+	}
+
+	return contract.WithdrawERC20(
+		opts,
+		common.HexToAddress(deposit.WithdrawalToken),
+		amount,
+		receiver,
+		txHash,
+		txNonce,
+		deposit.IsWrappedToken,
+		signatures,
+	)
+}
@@ -0,0 +1,281 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	v1 "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/contracts/v1"
+	v2 "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/contracts/v2"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+const relayerKey = "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+
+// fakeEth serves the eth namespace calls made by the relayer and the finality checks.
+type fakeEth struct {
+	mu            sync.Mutex
+	pendingNonce  uint64
+	sendErr       error
+	receiptStatus uint64
+
+	blockNumber uint64
+	// tagged are the block numbers returned for the block tags, f.e. `finalized`
+	tagged          map[string]uint64
+	l1Confirmations uint64
+}
+
+func (f *fakeEth) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (f *fakeEth) GetTransactionCount(_ common.Address, _ string) hexutil.Uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return hexutil.Uint64(f.pendingNonce)
+}
+
+func (f *fakeEth) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(f.blockNumber)
+}
+
+func (f *fakeEth) GetBlockByNumber(tag string, _ bool) *types.Header {
+	number, ok := f.tagged[tag]
+	if !ok {
+		number = 1
+	}
+
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(1_000_000_000),
+	}
+}
+
+func (f *fakeEth) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1_000_000_000))
+}
+
+func (f *fakeEth) EstimateGas(_ map[string]interface{}) hexutil.Uint64 {
+	return 100_000
+}
+
+// Call serves the Arbitrum node interface L1 confirmations call.
+func (f *fakeEth) Call(_ map[string]interface{}, _ string) hexutil.Bytes {
+	return common.LeftPadBytes(new(big.Int).SetUint64(f.l1Confirmations).Bytes(), 32)
+}
+
+func (f *fakeEth) GetCode(_ common.Address, _ string) hexutil.Bytes {
+	return hexutil.Bytes{0x1}
+}
+
+func (f *fakeEth) SendRawTransaction(raw hexutil.Bytes) (common.Hash, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.sendErr != nil {
+		return common.Hash{}, f.sendErr
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return common.Hash{}, err
+	}
+
+	return tx.Hash(), nil
+}
+
+func (f *fakeEth) GetTransactionReceipt(hash common.Hash) *types.Receipt {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return &types.Receipt{
+		Status:      f.receiptStatus,
+		TxHash:      hash,
+		Logs:        []*types.Log{},
+		BlockNumber: big.NewInt(1),
+	}
+}
+
+// newTestRpc serves the fake service under the namespace via the in-process RPC client.
+func newTestRpc(t *testing.T, namespace string, service any) *ethclient.Client {
+	server := rpc.NewServer()
+	if err := server.RegisterName(namespace, service); err != nil {
+		t.Fatalf("failed to register fake %s service: %v", namespace, err)
+	}
+	client := ethclient.NewClient(rpc.DialInProc(server))
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+
+	return client
+}
+
+func newTestRelayer(t *testing.T, eth *fakeEth) *Relayer {
+	client := newTestRpc(t, "eth", eth)
+
+	contracts, err := decodeContracts([]interface{}{
+		map[string]interface{}{"address": oldBridge, "version": "v1"},
+		map[string]interface{}{"address": newBridge, "version": "v2", "withdrawal_tokens": []interface{}{token}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	relayer, err := NewRelayer(Chain{
+		Rpc:       client,
+		Contracts: contracts,
+		Meta:      Meta{Relayer: RelayerSettings{Enabled: true, ReceiptTimeout: time.Second}},
+	}, relayerKey)
+	if err != nil {
+		t.Fatalf("failed to create relayer: %v", err)
+	}
+
+	return relayer
+}
+
+func testWithdrawal(withdrawalToken string) db.Deposit {
+	return db.Deposit{
+		DepositIdentifier: db.DepositIdentifier{
+			TxHash:  "0x8f2c2a3bf5a2bc2e7b8e1b3c5b1a8d6f4e0f5c3a2b1d0e9f8a7b6c5d4e3f2a1b",
+			TxNonce: 1,
+		},
+		Receiver:         "0x00000000000000000000000000000000000000cc",
+		WithdrawalToken:  withdrawalToken,
+		WithdrawalAmount: "1000",
+	}
+}
+
+func Test_RelayerNonce(t *testing.T) {
+	eth := &fakeEth{pendingNonce: 5}
+	relayer := newTestRelayer(t, eth)
+	deposit := testWithdrawal(bridge.DefaultNativeTokenAddress)
+
+	steps := []struct {
+		name         string
+		pendingNonce uint64
+		sendErr      error
+		expected     uint64
+		err          bool
+	}{
+		{name: "fetched from node", pendingNonce: 5, expected: 5},
+		{name: "sent transaction not visible on node", pendingNonce: 5, expected: 6},
+		{name: "node ahead of local nonce", pendingNonce: 10, expected: 10},
+		{name: "send failure", pendingNonce: 10, sendErr: errors.New("nonce too low"), err: true},
+		{name: "re-fetched after failure", pendingNonce: 3, expected: 3},
+	}
+
+	for _, step := range steps {
+		eth.mu.Lock()
+		eth.pendingNonce, eth.sendErr = step.pendingNonce, step.sendErr
+		eth.mu.Unlock()
+
+		tx, err := relayer.Relay(context.Background(), deposit, make([]byte, 65))
+		if err != nil {
+			if !step.err {
+				t.Fatalf("%s: unexpected error: %v", step.name, err)
+			}
+
+			continue
+		}
+		if step.err {
+			t.Fatalf("%s: expected error, got nil", step.name)
+		}
+
+		if tx.Nonce() != step.expected {
+			t.Fatalf("%s: expected nonce %d, got %d", step.name, step.expected, tx.Nonce())
+		}
+	}
+}
+
+func Test_RelayerTransactor(t *testing.T) {
+	tests := map[string]struct {
+		token    string
+		contract string
+		v1       bool
+	}{
+		"native token withdrawn via v1 contract": {
+			token:    bridge.DefaultNativeTokenAddress,
+			contract: oldBridge,
+			v1:       true,
+		},
+		"configured token withdrawn via v2 contract": {
+			token:    token,
+			contract: newBridge,
+		},
+		"unknown token withdrawn via v1 contract": {
+			token:    "0x00000000000000000000000000000000000000dd",
+			contract: oldBridge,
+			v1:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			relayer := newTestRelayer(t, &fakeEth{})
+
+			tx, err := relayer.Relay(context.Background(), testWithdrawal(tc.token), make([]byte, 65))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if *tx.To() != common.HexToAddress(tc.contract) {
+				t.Fatalf("expected transaction to %s, got %s", tc.contract, tx.To())
+			}
+
+			switch transactor := relayer.bridges[*tx.To()].(type) {
+			case *v1.BridgeTransactor:
+				if !tc.v1 {
+					t.Fatal("expected v2 transactor, got v1")
+				}
+			case *v2.BridgeTransactor:
+				if tc.v1 {
+					t.Fatal("expected v1 transactor, got v2")
+				}
+			default:
+				t.Fatalf("unexpected transactor %T", transactor)
+			}
+		})
+	}
+}
+
+func Test_RelayerWaitForReceipt(t *testing.T) {
+	tests := map[string]struct {
+		status uint64
+		err    error
+	}{
+		"successful transaction": {status: types.ReceiptStatusSuccessful},
+		"failed transaction":     {status: types.ReceiptStatusFailed, err: bridgeTypes.ErrTxFailed},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			relayer := newTestRelayer(t, &fakeEth{receiptStatus: tc.status})
+
+			tx, err := relayer.Relay(context.Background(), testWithdrawal(bridge.DefaultNativeTokenAddress), make([]byte, 65))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			receipt, err := relayer.WaitForReceipt(context.Background(), tx)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if receipt.TxHash != tx.Hash() {
+				t.Fatalf("expected receipt for %s, got %s", tx.Hash(), receipt.TxHash)
+			}
+		})
+	}
+}
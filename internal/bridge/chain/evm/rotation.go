@@ -0,0 +1,307 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	v2 "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/contracts/v2"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	// signerRotationGasLimit covers any of the bridge owner calls,
+	// the fixed limit lets the parties verify the proposed transactions exactly
+	signerRotationGasLimit = 150_000
+	// maxFeeCapMultiplier bounds the proposed fee cap relative to the locally estimated one
+	maxFeeCapMultiplier = 2
+
+	methodAddSigners        = "addSigners"
+	methodRemoveSigners     = "removeSigners"
+	methodTransferOwnership = "transferOwnership"
+)
+
+var _ bridgeTypes.SignerRotator = &SignerRotator{}
+
+// SignerRotator changes the signer and the owner of the bridge contracts to the new TSS key.
+// The contracts must be owned by the current TSS address, the owner transactions are sent from it,
+// so it should hold enough native tokens to pay for the gas.
+type SignerRotator struct {
+	client  *Client
+	current common.Address
+	new     common.Address
+}
+
+func NewSignerRotator(client *Client, currentKey, newKey *ecdsa.PublicKey) *SignerRotator {
+	return &SignerRotator{
+		client:  client,
+		current: crypto.PubkeyToAddress(*currentKey),
+		new:     crypto.PubkeyToAddress(*newKey),
+	}
+}
+
+// rotationCalls are the owner calls executed for every bridge contract one by one:
+// the new signer is added, the current one is removed and the ownership is transferred to the new key.
+func (r *SignerRotator) rotationCalls() ([][]byte, error) {
+	calls := make([][]byte, 0, 3)
+	for _, call := range []struct {
+		method string
+		args   []interface{}
+	}{
+		{methodAddSigners, []interface{}{[]common.Address{r.new}}},
+		{methodRemoveSigners, []interface{}{[]common.Address{r.current}}},
+		{methodTransferOwnership, []interface{}{r.new}},
+	} {
+		data, err := r.client.abiV2.Pack(call.method, call.args...)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to pack %s call", call.method))
+		}
+		calls = append(calls, data)
+	}
+
+	return calls, nil
+}
+
+// rotationParams are the transactions parameters the proposed rotation is checked against.
+type rotationParams struct {
+	chainId *big.Int
+	nonce   uint64
+	tipCap  *big.Int
+	feeCap  *big.Int
+	calls   [][]byte
+}
+
+func (r *SignerRotator) params(ctx context.Context) (*rotationParams, error) {
+	rpc := r.client.chain.Rpc
+
+	for _, contract := range r.client.chain.Contracts {
+		caller, err := v2.NewBridgeCaller(contract.Address, rpc)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create bridge caller")
+		}
+		owner, err := caller.Owner(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to get %s owner", contract.Address))
+		}
+		if owner != r.current {
+			return nil, errors.New(fmt.Sprintf("bridge %s is owned by %s, not the current TSS address", contract.Address, owner))
+		}
+	}
+
+	chainId, err := rpc.ChainID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get chain id")
+	}
+	nonce, err := rpc.PendingNonceAt(ctx, r.current)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pending nonce")
+	}
+	tipCap, err := rpc.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to suggest gas tip cap")
+	}
+	head, err := rpc.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get latest header")
+	}
+	if head.BaseFee == nil {
+		return nil, errors.New("dynamic fee transactions are not supported by the chain")
+	}
+	calls, err := r.rotationCalls()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to form rotation calls")
+	}
+
+	return &rotationParams{
+		chainId: chainId,
+		nonce:   nonce,
+		tipCap:  tipCap,
+		feeCap:  new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), tipCap),
+		calls:   calls,
+	}, nil
+}
+
+func (r *SignerRotator) FormRotation(ctx context.Context) (*bridgeTypes.SignerRotation, error) {
+	params, err := r.params(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get rotation params")
+	}
+
+	signer := types.LatestSignerForChainID(params.chainId)
+	nonce := params.nonce
+
+	var (
+		txs     []hexutil.Bytes
+		sigData [][]byte
+	)
+	for _, contract := range r.client.chain.Contracts {
+		for _, call := range params.calls {
+			tx := types.NewTx(&types.DynamicFeeTx{
+				ChainID:   params.chainId,
+				Nonce:     nonce,
+				GasTipCap: params.tipCap,
+				GasFeeCap: params.feeCap,
+				Gas:       signerRotationGasLimit,
+				To:        &contract.Address,
+				Data:      call,
+			})
+			raw, err := tx.MarshalBinary()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to encode transaction")
+			}
+
+			txs = append(txs, raw)
+			sigData = append(sigData, signer.Hash(tx).Bytes())
+			nonce++
+		}
+	}
+
+	payload, err := json.Marshal(txs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode transactions")
+	}
+
+	return &bridgeTypes.SignerRotation{
+		Payload: payload,
+		SigData: sigData,
+	}, nil
+}
+
+func (r *SignerRotator) VerifyRotation(ctx context.Context, rotation bridgeTypes.SignerRotation) error {
+	params, err := r.params(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get rotation params")
+	}
+	txs, err := decodeRotationTxs(rotation.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode transactions")
+	}
+	if len(txs) != len(r.client.chain.Contracts)*len(params.calls) || len(rotation.SigData) != len(txs) {
+		return errors.New("unexpected transactions count")
+	}
+
+	maxFeeCap := new(big.Int).Mul(params.feeCap, big.NewInt(maxFeeCapMultiplier))
+	signer := types.LatestSignerForChainID(params.chainId)
+	for i, tx := range txs {
+		var (
+			contract = r.client.chain.Contracts[i/len(params.calls)].Address
+			call     = params.calls[i%len(params.calls)]
+		)
+
+		switch {
+		case tx.Type() != types.DynamicFeeTxType || tx.ChainId().Cmp(params.chainId) != 0:
+			return errors.New(fmt.Sprintf("transaction %d has invalid type or chain id", i))
+		case tx.Nonce() != params.nonce+uint64(i):
+			return errors.New(fmt.Sprintf("transaction %d has invalid nonce", i))
+		case tx.To() == nil || *tx.To() != contract || !bytes.Equal(tx.Data(), call) || tx.Value().Sign() != 0:
+			return errors.New(fmt.Sprintf("transaction %d does not match the expected call", i))
+		case tx.Gas() != signerRotationGasLimit || tx.GasFeeCap().Cmp(maxFeeCap) > 0 || tx.GasTipCap().Cmp(tx.GasFeeCap()) > 0:
+			return errors.New(fmt.Sprintf("transaction %d has invalid gas parameters", i))
+		case !bytes.Equal(signer.Hash(tx).Bytes(), rotation.SigData[i]):
+			return errors.New(fmt.Sprintf("sign data of transaction %d does not match the expected one", i))
+		}
+	}
+
+	return nil
+}
+
+func (r *SignerRotator) SubmitRotation(ctx context.Context, rotation bridgeTypes.SignerRotation, signatures [][]byte) (string, error) {
+	txs, err := decodeRotationTxs(rotation.Payload)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode transactions")
+	}
+	if len(txs) != len(signatures) {
+		return "", errors.New("invalid signatures count")
+	}
+
+	hashes := make([]string, 0, len(txs))
+	for i, tx := range txs {
+		signed, err := tx.WithSignature(types.LatestSignerForChainID(tx.ChainId()), signatures[i])
+		if err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("failed to sign transaction %d", i))
+		}
+		if err = r.client.chain.Rpc.SendTransaction(ctx, signed); err != nil {
+			return "", errors.Wrap(err, fmt.Sprintf("failed to send transaction %d", i))
+		}
+		hashes = append(hashes, signed.Hash().Hex())
+
+		// the owner calls depend on each other, so every transaction should succeed before the next one
+		if err = r.waitMined(ctx, signed); err != nil {
+			return strings.Join(hashes, ", "), errors.Wrap(err, fmt.Sprintf("transaction %s failed", signed.Hash()))
+		}
+	}
+
+	return strings.Join(hashes, ", "), nil
+}
+
+func (r *SignerRotator) waitMined(ctx context.Context, tx *types.Transaction) error {
+	ctx, cancel := context.WithTimeout(ctx, r.client.chain.Meta.Relayer.ReceiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(ctx, r.client.chain.Rpc, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to wait for transaction to be mined")
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return bridgeTypes.ErrTxFailed
+	}
+
+	return nil
+}
+
+func (r *SignerRotator) VerifyRotated(ctx context.Context) error {
+	opts := &bind.CallOpts{Context: ctx}
+
+	for _, contract := range r.client.chain.Contracts {
+		caller, err := v2.NewBridgeCaller(contract.Address, r.client.chain.Rpc)
+		if err != nil {
+			return errors.Wrap(err, "failed to create bridge caller")
+		}
+
+		signers, err := caller.GetSigners(opts)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to get %s signers", contract.Address))
+		}
+		if !slices.Contains(signers, r.new) || slices.Contains(signers, r.current) {
+			return errors.New(fmt.Sprintf("bridge %s signers were not rotated", contract.Address))
+		}
+
+		owner, err := caller.Owner(opts)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to get %s owner", contract.Address))
+		}
+		if owner != r.new {
+			return errors.New(fmt.Sprintf("bridge %s ownership was not transferred", contract.Address))
+		}
+	}
+
+	return nil
+}
+
+func decodeRotationTxs(payload []byte) ([]*types.Transaction, error) {
+	var raw []hexutil.Bytes
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to decode payload")
+	}
+
+	txs := make([]*types.Transaction, len(raw))
+	for i, data := range raw {
+		txs[i] = new(types.Transaction)
+		if err := txs[i].UnmarshalBinary(data); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to decode transaction %d", i))
+		}
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,89 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/indexer"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+var _ indexer.Scanner = &Client{}
+
+func (p *Client) ConfirmedHeight(ctx context.Context) (int64, error) {
+	height, err := p.confirmedHeight(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get confirmed height")
+	}
+
+	return int64(height), nil
+}
+
+// ScanDeposits looks for the v1 and v2 bridge deposit events in the given block range.
+// The deposit nonce is the index of the event log inside the transaction receipt.
+func (p *Client) ScanDeposits(ctx context.Context, from, to int64) ([]db.DepositIdentifier, error) {
+	topics := make([]common.Hash, 0, len(p.supportedEvents))
+	for topic := range p.supportedEvents {
+		topics = append(topics, common.HexToHash(topic))
+	}
+
+	logs, err := p.chain.Rpc.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(from),
+		ToBlock:   big.NewInt(to),
+		Addresses: p.chain.ContractAddresses(),
+		Topics:    [][]common.Hash{topics},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter logs")
+	}
+
+	var (
+		identifiers = make([]db.DepositIdentifier, 0, len(logs))
+		receipts    = make(map[common.Hash]*types.Receipt)
+	)
+	for _, log := range logs {
+		if log.Removed {
+			continue
+		}
+		// the contracts running side by side may share the event topics of different versions
+		if contract, ok := p.chain.Contract(log.Address); !ok || !contract.Version.Emits(p.GetDepositEventType(&log)) {
+			continue
+		}
+
+		receipt, ok := receipts[log.TxHash]
+		if !ok {
+			receipt, err = p.chain.Rpc.TransactionReceipt(ctx, log.TxHash)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get tx receipt")
+			}
+			receipts[log.TxHash] = receipt
+		}
+
+		nonce, err := logNonce(receipt, log)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get deposit nonce")
+		}
+
+		identifiers = append(identifiers, db.DepositIdentifier{
+			TxHash:  log.TxHash.Hex(),
+			TxNonce: nonce,
+			ChainId: p.chain.Id,
+		})
+	}
+
+	return identifiers, nil
+}
+
+func logNonce(receipt *types.Receipt, log types.Log) (int64, error) {
+	for idx, receiptLog := range receipt.Logs {
+		if receiptLog.Index == log.Index {
+			return int64(idx), nil
+		}
+	}
+
+	return 0, errors.New("log not found in the transaction receipt")
+}
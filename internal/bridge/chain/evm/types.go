@@ -1,5 +1,11 @@
 package evm
 
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
 type EventType string
 
 const (
@@ -30,3 +36,35 @@ var EventToEventName = map[EventType]string{
 	EventV1DepositedERC20:  EventNameDepositedERC20,
 	EventV2DepositedERC20:  EventNameDepositedERC20,
 }
+
+// ContractVersion is the ABI version of the bridge contract.
+type ContractVersion string
+
+const (
+	// ContractVersionAny is the version of the contract configured by the plain address,
+	// deposit events of both versions are accepted and the withdrawals are relayed via the v2 ABI
+	ContractVersionAny ContractVersion = ""
+	ContractVersionV1  ContractVersion = "v1"
+	ContractVersionV2  ContractVersion = "v2"
+)
+
+var eventVersions = map[EventType]ContractVersion{
+	EventV1DepositedNative: ContractVersionV1,
+	EventV1DepositedERC20:  ContractVersionV1,
+	EventV2DepositedNative: ContractVersionV2,
+	EventV2DepositedERC20:  ContractVersionV2,
+}
+
+func (v ContractVersion) Validate() error {
+	switch v {
+	case ContractVersionV1, ContractVersionV2:
+		return nil
+	default:
+		return errors.New(fmt.Sprintf("invalid contract version %q", v))
+	}
+}
+
+// Emits reports whether the contract of the version emits the event.
+func (v ContractVersion) Emits(event EventType) bool {
+	return v == ContractVersionAny || eventVersions[event] == v
+}
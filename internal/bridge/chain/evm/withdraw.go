@@ -11,6 +11,8 @@ import (
 
 type Operation interface {
 	CalculateHash() []byte
+	// StructHash returns the EIP-712 hash of the typed operation struct
+	StructHash() []byte
 }
 
 func (p *Client) WithdrawalAmountValid(amount *big.Int) bool {
@@ -21,15 +23,20 @@ func (p *Client) WithdrawalAmountValid(amount *big.Int) bool {
 	return true
 }
 
+// GetSignHash forms the hash verified by the contract the deposit withdrawal token is configured for.
 func (p *Client) GetSignHash(data db.Deposit) ([]byte, error) {
-	var operation Operation
-	var err error
-
-	if data.WithdrawalToken == bridge.DefaultNativeTokenAddress {
-		operation, err = operations.NewWithdrawNativeContent(data)
-	} else {
-		operation, err = operations.NewWithdrawERC20Content(data)
+	contract := p.chain.WithdrawalContract(data.WithdrawalToken)
+	if domain := p.chain.WithdrawalDomain(contract); domain != nil {
+		return TypedSignHash(data, *domain)
 	}
+
+	return SignHash(data)
+}
+
+// SignHash forms the prefixed hash of the bridge contract withdrawal operation.
+// The deposit receiver and withdrawal token are expected to be hex-encoded addresses.
+func SignHash(data db.Deposit) ([]byte, error) {
+	operation, err := newOperation(data)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create operation")
 	}
@@ -39,3 +46,21 @@ func (p *Client) GetSignHash(data db.Deposit) ([]byte, error) {
 
 	return prefixedHash, nil
 }
+
+// TypedSignHash forms the EIP-712 hash of the bridge contract withdrawal operation within the domain.
+func TypedSignHash(data db.Deposit, domain operations.Domain) ([]byte, error) {
+	operation, err := newOperation(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create operation")
+	}
+
+	return operations.TypedDataHash(domain, operation.StructHash()), nil
+}
+
+func newOperation(data db.Deposit) (Operation, error) {
+	if data.WithdrawalToken == bridge.DefaultNativeTokenAddress {
+		return operations.NewWithdrawNativeContent(data)
+	}
+
+	return operations.NewWithdrawERC20Content(data)
+}
@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"crypto/tls"
+	"reflect"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/figure/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const defaultCallTimeout = 30 * time.Second
+
+// Chain is the chain integrated by the external adapter process,
+// the bridge details and required confirmations are configured on the adapter side.
+type Chain struct {
+	Id      string
+	Adapter Adapter
+}
+
+type Adapter struct {
+	Conn *grpc.ClientConn
+	// CallTimeout limits every adapter call
+	CallTimeout time.Duration
+}
+
+func FromChain(c chain.Chain) Chain {
+	if c.Type != chain.TypePlugin {
+		panic("chain is not Plugin")
+	}
+
+	chain := Chain{Id: c.Id}
+
+	if err := figure.Out(&chain.Adapter).FromInterface(c.Rpc).With(adapterHook).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to obtain adapter connection"))
+	}
+
+	return chain
+}
+
+var adapterHook = figure.Hooks{
+	"plugin.Adapter": func(value interface{}) (reflect.Value, error) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var adapterConfig struct {
+				Addr        string        `fig:"addr,required"`
+				EnableTLS   bool          `fig:"enable_tls"`
+				CallTimeout time.Duration `fig:"call_timeout"`
+			}
+
+			if err := figure.Out(&adapterConfig).With(figure.BaseHooks).From(v).Please(); err != nil {
+				return reflect.Value{}, errors.Wrap(err, "failed to figure out adapter config")
+			}
+
+			securityOption := grpc.WithTransportCredentials(insecure.NewCredentials())
+			if adapterConfig.EnableTLS {
+				securityOption = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS13}))
+			}
+
+			conn, err := grpc.NewClient(adapterConfig.Addr, securityOption)
+			if err != nil {
+				return reflect.Value{}, errors.Wrap(err, "failed to connect to the adapter via gRPC")
+			}
+
+			if adapterConfig.CallTimeout == 0 {
+				adapterConfig.CallTimeout = defaultCallTimeout
+			}
+
+			return reflect.ValueOf(Adapter{
+				Conn:        conn,
+				CallTimeout: adapterConfig.CallTimeout,
+			}), nil
+		default:
+			return reflect.Value{}, errors.Errorf("unsupported conversion from %T", value)
+		}
+	},
+}
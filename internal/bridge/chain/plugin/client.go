@@ -0,0 +1,84 @@
+package plugin
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	adapterTypes "github.com/Bridgeless-Project/tss-svc/pkg/plugin"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Client delegates the chain operations to the external adapter implementing
+// the ChainAdapter gRPC service.
+type Client struct {
+	chain   Chain
+	adapter adapterTypes.ChainAdapterClient
+}
+
+func NewBridgeClient(chain Chain) *Client {
+	return &Client{
+		chain:   chain,
+		adapter: adapterTypes.NewChainAdapterClient(chain.Adapter.Conn),
+	}
+}
+
+func (c *Client) Chain() Chain {
+	return c.chain
+}
+
+func (c *Client) ChainId() string {
+	return c.chain.Id
+}
+
+func (c *Client) Type() chain.Type {
+	return chain.TypePlugin
+}
+
+func (c *Client) AddressValid(addr string) bool {
+	return c.valid(c.adapter.AddressValid, addr)
+}
+
+func (c *Client) TransactionHashValid(hash string) bool {
+	return c.valid(c.adapter.TransactionHashValid, hash)
+}
+
+func (c *Client) WithdrawalAmountValid(amount *big.Int) bool {
+	if amount == nil {
+		return false
+	}
+
+	return c.valid(c.adapter.WithdrawalAmountValid, amount.String())
+}
+
+func (c *Client) HealthCheck() error {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	if _, err := c.adapter.HealthCheck(ctx, &emptypb.Empty{}); err != nil {
+		return errors.Wrap(err, "adapter health check failed")
+	}
+
+	return nil
+}
+
+type validityCall func(ctx context.Context, in *adapterTypes.ValueRequest, opts ...grpc.CallOption) (*adapterTypes.ValidityResponse, error)
+
+// valid treats the adapter failures as the invalid value
+func (c *Client) valid(call validityCall, value string) bool {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	resp, err := call(ctx, &adapterTypes.ValueRequest{Value: value})
+	if err != nil {
+		return false
+	}
+
+	return resp.GetValid()
+}
+
+func (c *Client) callContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.chain.Adapter.CallTimeout)
+}
@@ -0,0 +1,82 @@
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	adapterTypes "github.com/Bridgeless-Project/tss-svc/pkg/plugin"
+	"github.com/pkg/errors"
+)
+
+var depositErrors = map[adapterTypes.DepositError]error{
+	adapterTypes.DepositError_DEPOSIT_ERROR_TX_PENDING:               bridgeTypes.ErrTxPending,
+	adapterTypes.DepositError_DEPOSIT_ERROR_TX_NOT_CONFIRMED:         bridgeTypes.ErrTxNotConfirmed,
+	adapterTypes.DepositError_DEPOSIT_ERROR_TX_FAILED:                bridgeTypes.ErrTxFailed,
+	adapterTypes.DepositError_DEPOSIT_ERROR_TX_NOT_FOUND:             bridgeTypes.ErrTxNotFound,
+	adapterTypes.DepositError_DEPOSIT_ERROR_DEPOSIT_NOT_FOUND:        bridgeTypes.ErrDepositNotFound,
+	adapterTypes.DepositError_DEPOSIT_ERROR_INVALID_RECEIVER_ADDRESS: bridgeTypes.ErrInvalidReceiverAddress,
+	adapterTypes.DepositError_DEPOSIT_ERROR_INVALID_DEPOSITED_AMOUNT: bridgeTypes.ErrInvalidDepositedAmount,
+	adapterTypes.DepositError_DEPOSIT_ERROR_UNSUPPORTED_EVENT:        bridgeTypes.ErrUnsupportedEvent,
+	adapterTypes.DepositError_DEPOSIT_ERROR_UNSUPPORTED_CONTRACT:     bridgeTypes.ErrUnsupportedContract,
+}
+
+func (c *Client) GetDepositData(id db.DepositIdentifier) (*db.DepositData, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	resp, err := c.adapter.GetDepositData(ctx, &types.DepositIdentifier{
+		ChainId: id.ChainId,
+		TxHash:  id.TxHash,
+		TxNonce: id.TxNonce,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get deposit data from adapter")
+	}
+
+	switch result := resp.GetResult().(type) {
+	case *adapterTypes.GetDepositDataResponse_Error:
+		return nil, toDepositError(result.Error)
+	case *adapterTypes.GetDepositDataResponse_Data:
+		return toDepositData(id, result.Data)
+	default:
+		return nil, errors.New("empty adapter response")
+	}
+}
+
+func toDepositError(depositErr adapterTypes.DepositError) error {
+	err, ok := depositErrors[depositErr]
+	if !ok {
+		return errors.New(fmt.Sprintf("unknown adapter deposit error %s", depositErr))
+	}
+
+	return err
+}
+
+func toDepositData(id db.DepositIdentifier, data *adapterTypes.DepositData) (*db.DepositData, error) {
+	if data == nil {
+		return nil, errors.New("empty deposit data")
+	}
+
+	amount, ok := new(big.Int).SetString(data.DepositAmount, 10)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("invalid deposit amount %q", data.DepositAmount))
+	}
+	if data.ReferralId > math.MaxUint16 {
+		return nil, errors.New(fmt.Sprintf("referral id %d exceeds the maximum value", data.ReferralId))
+	}
+
+	return &db.DepositData{
+		DepositIdentifier:  id,
+		Block:              data.Block,
+		SourceAddress:      data.SourceAddress,
+		DepositAmount:      amount,
+		TokenAddress:       data.TokenAddress,
+		ReferralId:         uint16(data.ReferralId),
+		DestinationAddress: data.DestinationAddress,
+		DestinationChainId: data.DestinationChainId,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package plugin
+
+import (
+	"math"
+	"testing"
+
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	adapterTypes "github.com/Bridgeless-Project/tss-svc/pkg/plugin"
+	"github.com/pkg/errors"
+)
+
+func Test_ToDepositError(t *testing.T) {
+	for value := range adapterTypes.DepositError_name {
+		depositErr := adapterTypes.DepositError(value)
+		err := toDepositError(depositErr)
+
+		if depositErr == adapterTypes.DepositError_DEPOSIT_ERROR_UNSPECIFIED {
+			if bridgeTypes.IsPendingDepositError(err) || bridgeTypes.IsInvalidDepositError(err) {
+				t.Fatalf("unspecified error must not be classified, got %v", err)
+			}
+			continue
+		}
+
+		if !bridgeTypes.IsPendingDepositError(err) && !bridgeTypes.IsInvalidDepositError(err) {
+			t.Fatalf("adapter error %s is not mapped to the deposit error, got %v", depositErr, err)
+		}
+	}
+
+	if err := toDepositError(adapterTypes.DepositError_DEPOSIT_ERROR_TX_PENDING); !errors.Is(err, bridgeTypes.ErrTxPending) {
+		t.Fatalf("expected %v, got %v", bridgeTypes.ErrTxPending, err)
+	}
+}
+
+func Test_ToDepositData(t *testing.T) {
+	id := db.DepositIdentifier{ChainId: "plugin", TxHash: "0xabc", TxNonce: 1}
+
+	tests := map[string]struct {
+		data   *adapterTypes.DepositData
+		amount string
+		err    bool
+	}{
+		"valid data": {
+			data: &adapterTypes.DepositData{
+				Block:              100,
+				DepositAmount:      "1000000000000000000000",
+				ReferralId:         7,
+				DestinationChainId: "1",
+			},
+			amount: "1000000000000000000000",
+		},
+		"invalid data (empty)": {
+			err: true,
+		},
+		"invalid data (amount not decimal)": {
+			data: &adapterTypes.DepositData{DepositAmount: "0x10"},
+			err:  true,
+		},
+		"invalid data (referral id overflow)": {
+			data: &adapterTypes.DepositData{DepositAmount: "1", ReferralId: math.MaxUint16 + 1},
+			err:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := toDepositData(id, tc.data)
+			if err != nil {
+				if !tc.err {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+			if tc.err {
+				t.Fatal("expected error, got nil")
+			}
+
+			if data.DepositIdentifier != id {
+				t.Fatalf("expected identifier %v, got %v", id, data.DepositIdentifier)
+			}
+			if data.DepositAmount.String() != tc.amount {
+				t.Fatalf("expected amount %s, got %s", tc.amount, data.DepositAmount)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	adapterTypes "github.com/Bridgeless-Project/tss-svc/pkg/plugin"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/pkg/errors"
+)
+
+// FormSigningData asks the adapter to form the withdrawal of the deposits,
+// the returned signing data may include only a part of them.
+func (c *Client) FormSigningData(deposits []db.Deposit) (*adapterTypes.SigningData, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	data, err := c.adapter.FormSigningData(ctx, &adapterTypes.FormSigningDataRequest{
+		Deposits: toAdapterDeposits(deposits),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to form signing data by adapter")
+	}
+
+	return data, nil
+}
+
+// ValidateSigningData asks the adapter to check the signing data proposed by another party.
+func (c *Client) ValidateSigningData(data *adapterTypes.SigningData, deposits []db.Deposit) (bool, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	resp, err := c.adapter.ValidateSigningData(ctx, &adapterTypes.ValidateSigningDataRequest{
+		SigningData: data,
+		Deposits:    toAdapterDeposits(deposits),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to validate signing data by adapter")
+	}
+	if !resp.Valid {
+		return false, errors.New(fmt.Sprintf("signing data rejected by adapter: %s", resp.Reason))
+	}
+
+	return true, nil
+}
+
+// Finalize passes the produced signatures to the adapter and returns the withdrawal details
+// to be stored for the signed deposits.
+func (c *Client) Finalize(
+	data *adapterTypes.SigningData,
+	signatures []*common.SignatureData,
+	leader bool,
+) ([]*adapterTypes.ProcessedDeposit, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	sigs := make([]*adapterTypes.Signature, len(signatures))
+	for i, sig := range signatures {
+		if sig == nil {
+			return nil, errors.New(fmt.Sprintf("missing signature at position %d", i))
+		}
+		sigs[i] = &adapterTypes.Signature{
+			Signature: sig.Signature,
+			Recovery:  sig.SignatureRecovery,
+		}
+	}
+
+	resp, err := c.adapter.Finalize(ctx, &adapterTypes.FinalizeRequest{
+		SigningData: data,
+		Signatures:  sigs,
+		Leader:      leader,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to finalize withdrawal by adapter")
+	}
+
+	return resp.Deposits, nil
+}
+
+func toAdapterDeposits(deposits []db.Deposit) []*adapterTypes.Deposit {
+	result := make([]*adapterTypes.Deposit, len(deposits))
+	for i, deposit := range deposits {
+		result[i] = &adapterTypes.Deposit{
+			Identifier: &types.DepositIdentifier{
+				ChainId: deposit.ChainId,
+				TxHash:  deposit.TxHash,
+				TxNonce: deposit.TxNonce,
+			},
+			Depositor:         deposit.Depositor,
+			DepositAmount:     deposit.DepositAmount,
+			DepositToken:      deposit.DepositToken,
+			DepositBlock:      uint64(deposit.DepositBlock),
+			ReferralId:        uint32(deposit.ReferralId),
+			Receiver:          deposit.Receiver,
+			WithdrawalChainId: deposit.WithdrawalChainId,
+			WithdrawalToken:   deposit.WithdrawalToken,
+			WithdrawalAmount:  deposit.WithdrawalAmount,
+			CommissionAmount:  deposit.CommissionAmount,
+			IsWrappedToken:    deposit.IsWrappedToken,
+		}
+	}
+
+	return result
+}
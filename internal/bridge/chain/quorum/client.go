@@ -0,0 +1,266 @@
+package quorum
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/pkg/errors"
+)
+
+var ErrQuorumNotReached = errors.New("providers quorum not reached")
+
+// scanner is the subset of the indexer.Scanner implemented by the providers supporting the indexing.
+type scanner interface {
+	ConfirmedHeight(ctx context.Context) (int64, error)
+	ScanDeposits(ctx context.Context, from, to int64) ([]db.DepositIdentifier, error)
+}
+
+var _ chain.Wrapper = &Client{}
+
+// Client verifies the deposits by querying the same chain via multiple providers in parallel.
+// The deposit data and the confirmed height are accepted only if threshold providers agree on them,
+// the rest of the calls are served by the primary provider.
+type Client struct {
+	chain.Client
+
+	providers []chain.Client
+	threshold int
+
+	mu sync.Mutex
+	// disagreement is the last providers disagreement on the deposit data,
+	// it is reported by the health check until the next deposit is fetched without conflicts
+	disagreement error
+}
+
+// NewClient wraps the providers of the same chain, the first one is the primary.
+func NewClient(providers []chain.Client, threshold int) *Client {
+	if len(providers) == 0 {
+		panic("no providers configured")
+	}
+	// a minority threshold would let two disagreeing groups of providers both reach the quorum
+	if threshold <= len(providers)/2 || threshold > len(providers) {
+		panic(fmt.Sprintf("quorum threshold must be in range [%d, %d]", len(providers)/2+1, len(providers)))
+	}
+
+	return &Client{
+		Client:    providers[0],
+		providers: providers,
+		threshold: threshold,
+	}
+}
+
+func (c *Client) Unwrap() []chain.Client {
+	return c.providers
+}
+
+type depositResponse struct {
+	data *db.DepositData
+	err  error
+}
+
+type dataGroup struct {
+	data      *db.DepositData
+	providers []int
+}
+
+func (c *Client) GetDepositData(id db.DepositIdentifier) (*db.DepositData, error) {
+	responses := make([]depositResponse, len(c.providers))
+	c.parallel(func(i int, provider chain.Client) {
+		responses[i].data, responses[i].err = provider.GetDepositData(id)
+	})
+
+	groups := groupByData(responses)
+	c.mu.Lock()
+	c.disagreement = disagreement(id, responses, groups)
+	c.mu.Unlock()
+
+	return c.resolve(responses, groups)
+}
+
+// resolve selects the deposit data or the deposit error reported by the quorum of the providers.
+func (c *Client) resolve(responses []depositResponse, groups []dataGroup) (*db.DepositData, error) {
+	for _, group := range groups {
+		if len(group.providers) >= c.threshold {
+			return group.data, nil
+		}
+	}
+
+	var (
+		errs    = make(map[error][]int)
+		pending int
+	)
+	for i, resp := range responses {
+		// the provider failures are not the deposit verification results
+		if resp.err == nil || !(chain.IsPendingDepositError(resp.err) || chain.IsInvalidDepositError(resp.err)) {
+			continue
+		}
+		cause := errors.Cause(resp.err)
+		errs[cause] = append(errs[cause], i)
+		if chain.IsPendingDepositError(resp.err) {
+			pending++
+		}
+	}
+	for _, providers := range errs {
+		if len(providers) >= c.threshold {
+			return nil, responses[providers[0]].err
+		}
+	}
+
+	// the lagging providers may confirm the same deposit later
+	if len(groups) <= 1 && pending > 0 {
+		agreeing := 0
+		if len(groups) == 1 {
+			agreeing = len(groups[0].providers)
+		}
+		if agreeing+pending >= c.threshold {
+			return nil, chain.ErrTxNotConfirmed
+		}
+	}
+
+	failures := make([]string, 0, len(responses))
+	for i, resp := range responses {
+		if resp.err != nil {
+			failures = append(failures, fmt.Sprintf("provider %d: %s", i, resp.err))
+		}
+	}
+
+	return nil, errors.Wrap(ErrQuorumNotReached, strings.Join(failures, "; "))
+}
+
+func groupByData(responses []depositResponse) []dataGroup {
+	var groups []dataGroup
+	for i, resp := range responses {
+		if resp.err != nil {
+			continue
+		}
+
+		idx := slices.IndexFunc(groups, func(g dataGroup) bool { return sameDeposit(*g.data, *resp.data) })
+		if idx == -1 {
+			groups = append(groups, dataGroup{data: resp.data})
+			idx = len(groups) - 1
+		}
+		groups[idx].providers = append(groups[idx].providers, i)
+	}
+
+	return groups
+}
+
+// disagreement reports the providers returned different deposit data
+// or the deposit data along with the invalid deposit errors.
+func disagreement(id db.DepositIdentifier, responses []depositResponse, groups []dataGroup) error {
+	var invalid []int
+	for i, resp := range responses {
+		if resp.err != nil && chain.IsInvalidDepositError(resp.err) {
+			invalid = append(invalid, i)
+		}
+	}
+	if len(groups) <= 1 && (len(groups) == 0 || len(invalid) == 0) {
+		return nil
+	}
+
+	details := make([]string, 0, len(groups)+1)
+	for _, group := range groups {
+		details = append(details, fmt.Sprintf("providers %v returned the deposit at block %d", group.providers, group.data.Block))
+	}
+	if len(invalid) > 0 {
+		details = append(details, fmt.Sprintf("providers %v reported the invalid deposit", invalid))
+	}
+
+	return errors.New(fmt.Sprintf("providers disagree on deposit %s: %s", id, strings.Join(details, "; ")))
+}
+
+// ConfirmedHeight returns the highest block confirmed by at least threshold providers.
+func (c *Client) ConfirmedHeight(ctx context.Context) (int64, error) {
+	var (
+		mu      sync.Mutex
+		heights []int64
+	)
+	c.parallel(func(_ int, provider chain.Client) {
+		scanner, ok := provider.(scanner)
+		if !ok {
+			return
+		}
+		height, err := scanner.ConfirmedHeight(ctx)
+		if err != nil {
+			return
+		}
+
+		mu.Lock()
+		heights = append(heights, height)
+		mu.Unlock()
+	})
+
+	if len(heights) < c.threshold {
+		return 0, errors.Wrap(ErrQuorumNotReached, fmt.Sprintf("%d providers returned confirmed height", len(heights)))
+	}
+	slices.Sort(heights)
+
+	return heights[len(heights)-c.threshold], nil
+}
+
+// ScanDeposits scans the deposits via the primary provider,
+// the found deposits are verified by the quorum when fetched.
+func (c *Client) ScanDeposits(ctx context.Context, from, to int64) ([]db.DepositIdentifier, error) {
+	scanner, ok := c.Client.(scanner)
+	if !ok {
+		return nil, errors.New("deposits scanning is not supported by the chain")
+	}
+
+	return scanner.ScanDeposits(ctx, from, to)
+}
+
+// HealthCheck reports the unhealthy providers and the last disagreement on the deposit data.
+func (c *Client) HealthCheck() error {
+	errs := make([]error, len(c.providers))
+	c.parallel(func(i int, provider chain.Client) {
+		errs[i] = provider.HealthCheck()
+	})
+
+	var problems []string
+	for i, err := range errs {
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("provider %d: %s", i, err))
+		}
+	}
+
+	c.mu.Lock()
+	if c.disagreement != nil {
+		problems = append(problems, c.disagreement.Error())
+	}
+	c.mu.Unlock()
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(problems, "; "))
+}
+
+func (c *Client) parallel(call func(i int, provider chain.Client)) {
+	var wg sync.WaitGroup
+	wg.Add(len(c.providers))
+	for i, provider := range c.providers {
+		go func() {
+			defer wg.Done()
+			call(i, provider)
+		}()
+	}
+	wg.Wait()
+}
+
+func sameDeposit(a, b db.DepositData) bool {
+	return a.DepositIdentifier == b.DepositIdentifier &&
+		a.Block == b.Block &&
+		a.SourceAddress == b.SourceAddress &&
+		a.TokenAddress == b.TokenAddress &&
+		a.ReferralId == b.ReferralId &&
+		a.DestinationAddress == b.DestinationAddress &&
+		a.DestinationChainId == b.DestinationChainId &&
+		(a.DepositAmount == nil) == (b.DepositAmount == nil) &&
+		(a.DepositAmount == nil || a.DepositAmount.Cmp(b.DepositAmount) == 0)
+}
@@ -0,0 +1,177 @@
+package quorum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/pkg/errors"
+)
+
+type mockProvider struct {
+	chain.Client
+
+	data   *db.DepositData
+	err    error
+	height int64
+}
+
+func (m mockProvider) GetDepositData(db.DepositIdentifier) (*db.DepositData, error) {
+	return m.data, m.err
+}
+
+func (m mockProvider) HealthCheck() error {
+	return nil
+}
+
+func (m mockProvider) ConfirmedHeight(context.Context) (int64, error) {
+	return m.height, m.err
+}
+
+func (m mockProvider) ScanDeposits(context.Context, int64, int64) ([]db.DepositIdentifier, error) {
+	return nil, nil
+}
+
+func deposit(amount int64) *db.DepositData {
+	return &db.DepositData{
+		DepositIdentifier:  db.DepositIdentifier{TxHash: "0x01", ChainId: "1"},
+		Block:              10,
+		DepositAmount:      big.NewInt(amount),
+		DestinationChainId: "2",
+	}
+}
+
+func Test_GetDepositData(t *testing.T) {
+	failed := errors.New("connection refused")
+
+	tests := map[string]struct {
+		providers []mockProvider
+		threshold int
+		expected  *db.DepositData
+		err       error
+		conflict  bool
+	}{
+		"all agree": {
+			providers: []mockProvider{{data: deposit(1)}, {data: deposit(1)}, {data: deposit(1)}},
+			threshold: 3,
+			expected:  deposit(1),
+		},
+		"quorum agrees, one provider failed": {
+			providers: []mockProvider{{data: deposit(1)}, {err: failed}, {data: deposit(1)}},
+			threshold: 2,
+			expected:  deposit(1),
+		},
+		"quorum agrees, one provider returned different data": {
+			providers: []mockProvider{{data: deposit(1)}, {data: deposit(2)}, {data: deposit(1)}},
+			threshold: 2,
+			expected:  deposit(1),
+			conflict:  true,
+		},
+		"providers disagree": {
+			providers: []mockProvider{{data: deposit(1)}, {data: deposit(2)}, {err: failed}},
+			threshold: 2,
+			err:       ErrQuorumNotReached,
+			conflict:  true,
+		},
+		"quorum reports invalid deposit": {
+			providers: []mockProvider{{err: chain.ErrTxFailed}, {err: errors.Wrap(chain.ErrTxFailed, "receipt")}, {data: deposit(1)}},
+			threshold: 2,
+			err:       chain.ErrTxFailed,
+			conflict:  true,
+		},
+		"lagging provider": {
+			providers: []mockProvider{{data: deposit(1)}, {err: chain.ErrTxNotConfirmed}, {err: failed}},
+			threshold: 2,
+			err:       chain.ErrTxNotConfirmed,
+		},
+		"two groups disagree": {
+			providers: []mockProvider{{data: deposit(1)}, {data: deposit(1)}, {data: deposit(2)}, {data: deposit(2)}},
+			threshold: 3,
+			err:       ErrQuorumNotReached,
+			conflict:  true,
+		},
+		"not enough responses": {
+			providers: []mockProvider{{data: deposit(1)}, {err: failed}, {err: failed}},
+			threshold: 2,
+			err:       ErrQuorumNotReached,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			providers := make([]chain.Client, len(tc.providers))
+			for i, provider := range tc.providers {
+				providers[i] = provider
+			}
+			client := NewClient(providers, tc.threshold)
+
+			data, err := client.GetDepositData(db.DepositIdentifier{TxHash: "0x01", ChainId: "1"})
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if tc.expected != nil && (data == nil || !sameDeposit(*data, *tc.expected)) {
+				t.Fatalf("expected deposit %v, got %v", tc.expected, data)
+			}
+			if conflict := client.HealthCheck() != nil; conflict != tc.conflict {
+				t.Fatalf("expected conflict %v, got %v", tc.conflict, conflict)
+			}
+		})
+	}
+}
+
+func Test_ConfirmedHeight(t *testing.T) {
+	providers := []chain.Client{mockProvider{height: 100}, mockProvider{height: 90}, mockProvider{height: 110}}
+
+	height, err := NewClient(providers, 2).ConfirmedHeight(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if height != 100 {
+		t.Fatalf("expected height 100, got %d", height)
+	}
+
+	providers[2] = mockProvider{err: errors.New("connection refused")}
+	if _, err = NewClient(providers, 3).ConfirmedHeight(context.Background()); !errors.Is(err, ErrQuorumNotReached) {
+		t.Fatalf("expected quorum error, got %v", err)
+	}
+}
+
+func Test_Threshold(t *testing.T) {
+	tests := map[string]struct {
+		providers int
+		threshold int
+		valid     bool
+	}{
+		"single provider":            {providers: 1, threshold: 1, valid: true},
+		"majority of odd providers":  {providers: 3, threshold: 2, valid: true},
+		"majority of even providers": {providers: 4, threshold: 3, valid: true},
+		"all providers":              {providers: 4, threshold: 4, valid: true},
+		"half of providers":          {providers: 4, threshold: 2},
+		"minority of providers":      {providers: 3, threshold: 1},
+		"more than providers":        {providers: 3, threshold: 4},
+		"zero threshold":             {providers: 1, threshold: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			quorum := chain.Quorum{Rpc: make([]any, tc.providers-1), Threshold: tc.threshold}
+			// quorum with no additional providers is disabled and not validated
+			if err := quorum.Validate(); tc.providers > 1 && (err == nil) != tc.valid {
+				t.Fatalf("expected valid %v, got error %v", tc.valid, err)
+			}
+
+			providers := make([]chain.Client, tc.providers)
+			for i := range providers {
+				providers[i] = mockProvider{}
+			}
+			defer func() {
+				if panicked := recover() != nil; panicked == tc.valid {
+					t.Fatalf("expected valid %v, got panic %v", tc.valid, panicked)
+				}
+			}()
+			NewClient(providers, tc.threshold)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package chain
+
+import "context"
+
+// SignerRotation is the change of the bridge contract signer from the current TSS key to the new one.
+type SignerRotation struct {
+	// Payload is the chain-specific change data (f.e. the unsigned transactions)
+	Payload []byte
+	// SigData are the hashes to be signed by the current TSS key, one per signature required by the change
+	SigData [][]byte
+}
+
+// SignerRotator forms, verifies and submits the bridge signer rotation for the chain.
+type SignerRotator interface {
+	// FormRotation forms the rotation proposed to the rest of the parties
+	FormRotation(ctx context.Context) (*SignerRotation, error)
+	// VerifyRotation ensures the proposed rotation changes the signer to the new key only
+	VerifyRotation(ctx context.Context, rotation SignerRotation) error
+	// SubmitRotation submits the rotation signed by the current key, returns the submitted transactions reference
+	SubmitRotation(ctx context.Context, rotation SignerRotation, signatures [][]byte) (string, error)
+	// VerifyRotated ensures the new key is set as the bridge signer on-chain
+	VerifyRotated(ctx context.Context) error
+}
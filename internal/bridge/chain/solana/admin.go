@@ -0,0 +1,162 @@
+package solana
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana/contract"
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+)
+
+// AdminAction is the bridge program administration instruction.
+type AdminAction string
+
+const (
+	AdminActionInitialize      AdminAction = "initialize"
+	AdminActionChangeAuthority AdminAction = "change_authority"
+	AdminActionInitSplVault    AdminAction = "init_spl_vault"
+	AdminActionInitWrappedMint AdminAction = "init_wrapped_mint"
+)
+
+// AdminOperation contains the parameters of the administration instruction,
+// only the ones required by the action are set.
+type AdminOperation struct {
+	Action AdminAction
+
+	// Authorities are the compressed secp256k1 public keys of the bridge authority
+	// set by the initialize and change_authority actions
+	Authorities [][33]byte
+	// Nonce is the new authority nonce set by the change_authority action
+	Nonce uint64
+
+	// Mint is the SPL token the vault is initialized for by the init_spl_vault action
+	Mint solana.PublicKey
+
+	// Wrapped is the token minted by the bridge program initialized by the init_wrapped_mint action
+	Wrapped WrappedMintParams
+}
+
+type WrappedMintParams struct {
+	WrappedMint
+
+	Name     string
+	Uri      string
+	Decimals uint8
+}
+
+func (o AdminOperation) String() string {
+	switch o.Action {
+	case AdminActionInitialize, AdminActionChangeAuthority:
+		return fmt.Sprintf("%s (%d authorities, nonce %d)", o.Action, len(o.Authorities), o.Nonce)
+	case AdminActionInitSplVault:
+		return fmt.Sprintf("%s (mint %s)", o.Action, o.Mint)
+	default:
+		return fmt.Sprintf("%s (%s, mint nonce %d)", o.Action, o.Wrapped.Symbol, o.Wrapped.MintNonce)
+	}
+}
+
+// Authority returns the current bridge authority signers and nonce.
+func (p *Client) Authority(ctx context.Context) (*contract.AuthorityAccount, error) {
+	authority, _, err := contract.NewChangeAuthorityInstructionBuilder().FindAuthorityAddress(p.chain.Meta.BridgeId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find authority address")
+	}
+
+	var account contract.AuthorityAccount
+	if err = p.chain.Rpc.GetAccountDataBorshInto(ctx, authority, &account); err != nil {
+		return nil, errors.Wrap(err, "failed to get authority account")
+	}
+
+	return &account, nil
+}
+
+// GetAdminSignHash returns the authority message hash the program verifies the TSS signature over.
+// Nil hash is returned for the actions not authorized by the bridge authority.
+func (p *Client) GetAdminSignHash(op AdminOperation) ([]byte, error) {
+	if op.Action != AdminActionChangeAuthority {
+		return nil, nil
+	}
+	if len(op.Authorities) == 0 {
+		return nil, errors.New("no authorities provided")
+	}
+
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, op.Nonce)
+
+	buffer := []byte(AdminActionChangeAuthority)
+	buffer = append(buffer, []byte(p.chain.Meta.BridgeId)...)
+	for _, authority := range op.Authorities {
+		buffer = append(buffer, authority[:]...)
+	}
+	buffer = append(buffer, nonceBytes...)
+
+	hash := sha256.Sum256(buffer)
+	return hash[:], nil
+}
+
+// AdminInstruction builds the administration instruction paid by the signer,
+// the TSS signature is required for the actions authorized by the bridge authority only.
+func (p *Client) AdminInstruction(op AdminOperation, signature []byte, signer solana.PublicKey) (solana.Instruction, error) {
+	bridgeId := p.chain.Meta.BridgeId
+
+	switch op.Action {
+	case AdminActionInitialize:
+		authority, _, err := contract.NewInitializeInstructionBuilder().FindAuthorityAddress(bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find authority address")
+		}
+		return buildInstruction(contract.NewInitializeInstruction(
+			bridgeId, op.Authorities,
+			authority, signer, solana.SystemProgramID,
+		))
+	case AdminActionChangeAuthority:
+		if len(signature) != 65 {
+			return nil, errors.New("invalid signature length")
+		}
+		authority, _, err := contract.NewChangeAuthorityInstructionBuilder().FindAuthorityAddress(bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find authority address")
+		}
+		return buildInstruction(contract.NewChangeAuthorityInstruction(
+			bridgeId, op.Authorities, op.Nonce, [64]byte(signature[:64]), signature[64],
+			authority, signer,
+		))
+	case AdminActionInitSplVault:
+		vault, _, err := contract.NewInitSplVaultInstructionBuilder().FindSplVaultAddress(op.Mint, bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find spl vault address")
+		}
+		return buildInstruction(contract.NewInitSplVaultInstruction(
+			bridgeId,
+			op.Mint, vault, signer, solana.TokenProgramID, solana.SystemProgramID,
+		))
+	case AdminActionInitWrappedMint:
+		wrapped := op.Wrapped
+		mint, _, err := contract.NewInitWrappedMintInstructionBuilder().FindMintAddress(wrapped.Symbol, wrapped.MintNonce, bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find mint address")
+		}
+		return buildInstruction(contract.NewInitWrappedMintInstruction(
+			bridgeId, wrapped.Decimals, wrapped.MintNonce, wrapped.Symbol, wrapped.Name, wrapped.Uri,
+			mint, signer, solana.TokenProgramID, solana.SystemProgramID,
+		))
+	default:
+		return nil, errors.New(fmt.Sprintf("unsupported admin action %q", op.Action))
+	}
+}
+
+type instructionBuilder interface {
+	ValidateAndBuild() (*contract.Instruction, error)
+}
+
+func buildInstruction(builder instructionBuilder) (solana.Instruction, error) {
+	instruction, err := builder.ValidateAndBuild()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid instruction")
+	}
+
+	return instruction, nil
+}
@@ -2,6 +2,7 @@ package solana
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana/contract"
@@ -21,9 +22,41 @@ type Chain struct {
 }
 
 type Meta struct {
-	BridgeId string `fig:"bridge_id,required"`
+	BridgeId string          `fig:"bridge_id,required"`
+	Relayer  RelayerSettings `fig:"relayer"`
 }
 
+type RelayerSettings struct {
+	// Enabled defines whether the signed withdrawals should be sent
+	// to the bridge program by the session leader
+	Enabled bool `fig:"enabled"`
+	// PriorityFee is the compute unit price in micro-lamports
+	PriorityFee uint64 `fig:"priority_fee"`
+	// ComputeUnitLimit is the compute units requested by the withdrawal transaction,
+	// the default limit is used if not set
+	ComputeUnitLimit uint32 `fig:"compute_unit_limit"`
+	// Commitment is the level the withdrawal transaction should be confirmed at
+	Commitment rpc.CommitmentType `fig:"commitment"`
+	// ConfirmTimeout is the maximum time to wait for the withdrawal transaction confirmation
+	ConfirmTimeout time.Duration `fig:"confirm_timeout"`
+	// MaxRetries is the number of times the transaction is re-sent with the new blockhash after expiry,
+	// the default number is used if not set, zero disables the retries
+	MaxRetries *int `fig:"max_retries"`
+	// WrappedMints are the wrapped tokens minted by the bridge program,
+	// their seeds are required to withdraw them
+	WrappedMints []WrappedMint `fig:"wrapped_mints"`
+}
+
+type WrappedMint struct {
+	Symbol    string `fig:"symbol,required"`
+	MintNonce uint64 `fig:"mint_nonce"`
+}
+
+const (
+	defaultConfirmTimeout = 2 * time.Minute
+	defaultMaxRetries     = 3
+)
+
 var SolanaHooks = figure.Hooks{
 	"*rpc.Client": func(value interface{}) (reflect.Value, error) {
 		switch v := value.(type) {
@@ -60,6 +93,19 @@ func FromChain(c chain.Chain) Chain {
 	if err := figure.Out(&chain.Meta).FromInterface(c.Meta).Please(); err != nil {
 		panic(errors.Wrap(err, "failed to decode chain meta"))
 	}
+	if chain.Meta.Relayer.Commitment == "" {
+		chain.Meta.Relayer.Commitment = rpc.CommitmentConfirmed
+	}
+	if chain.Meta.Relayer.ConfirmTimeout == 0 {
+		chain.Meta.Relayer.ConfirmTimeout = defaultConfirmTimeout
+	}
+	if chain.Meta.Relayer.MaxRetries == nil {
+		retries := defaultMaxRetries
+		chain.Meta.Relayer.MaxRetries = &retries
+	}
+	if *chain.Meta.Relayer.MaxRetries < 0 {
+		panic(errors.New("max retries should not be negative"))
+	}
 	if err := figure.Out(&chain.Rpc).FromInterface(c.Rpc).With(SolanaHooks).Please(); err != nil {
 		panic(errors.Wrap(err, "failed to obtain Solana clients"))
 	}
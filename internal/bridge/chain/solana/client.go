@@ -21,6 +21,10 @@ func NewBridgeClient(chain Chain) *Client {
 	}
 }
 
+func (p *Client) Chain() Chain {
+	return p.chain
+}
+
 func (p *Client) ChainId() string {
 	return p.chain.Id
 }
@@ -0,0 +1,313 @@
+package solana
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana/contract"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/gagliardetto/solana-go"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	computebudget "github.com/gagliardetto/solana-go/programs/compute-budget"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/pkg/errors"
+)
+
+const confirmationPollInterval = 2 * time.Second
+
+var errBlockhashExpired = errors.New("transaction blockhash expired")
+
+// commitmentLevels orders the confirmation statuses to check the required commitment is reached.
+var commitmentLevels = map[rpc.ConfirmationStatusType]int{
+	rpc.ConfirmationStatusProcessed: 1,
+	rpc.ConfirmationStatusConfirmed: 2,
+	rpc.ConfirmationStatusFinalized: 3,
+}
+
+// Relayer sends the signed withdrawals to the bridge program from the configured fee payer.
+type Relayer struct {
+	chain  Chain
+	client *Client
+	payer  solana.PrivateKey
+
+	// wrappedMints maps the wrapped token mint to its seeds
+	wrappedMints map[solana.PublicKey]WrappedMint
+}
+
+func NewRelayer(chain Chain, rawKey string) (*Relayer, error) {
+	payer, err := solana.PrivateKeyFromBase58(rawKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse relayer private key")
+	}
+	if _, ok := commitmentLevels[rpc.ConfirmationStatusType(chain.Meta.Relayer.Commitment)]; !ok {
+		return nil, errors.New(fmt.Sprintf("unsupported commitment %q", chain.Meta.Relayer.Commitment))
+	}
+
+	wrappedMints := make(map[solana.PublicKey]WrappedMint, len(chain.Meta.Relayer.WrappedMints))
+	for _, wrapped := range chain.Meta.Relayer.WrappedMints {
+		mint, _, err := contract.NewWithdrawWrappedInstructionBuilder().
+			FindMintAddress(wrapped.Symbol, wrapped.MintNonce, chain.Meta.BridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to find %s mint address", wrapped.Symbol))
+		}
+		wrappedMints[mint] = wrapped
+	}
+
+	return &Relayer{
+		chain:        chain,
+		client:       NewBridgeClient(chain),
+		payer:        payer,
+		wrappedMints: wrappedMints,
+	}, nil
+}
+
+func (r *Relayer) Address() solana.PublicKey {
+	return r.payer.PublicKey()
+}
+
+// Relay sends the withdrawal transaction for the given deposit signed with the provided TSS signature
+// and waits until it is confirmed.
+func (r *Relayer) Relay(ctx context.Context, deposit db.Deposit, signature []byte) (solana.Signature, error) {
+	instructions, err := r.withdrawalInstructions(ctx, deposit, signature)
+	if err != nil {
+		return solana.Signature{}, errors.Wrap(err, "failed to build withdrawal instructions")
+	}
+
+	return r.Send(ctx, instructions...)
+}
+
+// Send sends the transaction with the given instructions from the fee payer with the compute unit price set
+// and waits until it is confirmed at the configured commitment.
+// The transaction is re-sent with the new blockhash if the previous one expired before the confirmation.
+func (r *Relayer) Send(ctx context.Context, instructions ...solana.Instruction) (solana.Signature, error) {
+	budget := []solana.Instruction{
+		computebudget.NewSetComputeUnitPriceInstruction(r.chain.Meta.Relayer.PriorityFee).Build(),
+	}
+	if limit := r.chain.Meta.Relayer.ComputeUnitLimit; limit > 0 {
+		budget = append(budget, computebudget.NewSetComputeUnitLimitInstruction(limit).Build())
+	}
+	instructions = append(budget, instructions...)
+
+	ctx, cancel := context.WithTimeout(ctx, r.chain.Meta.Relayer.ConfirmTimeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		txSig, err := r.send(ctx, instructions)
+		if err == nil {
+			return txSig, nil
+		}
+		if !errors.Is(err, errBlockhashExpired) || attempt >= *r.chain.Meta.Relayer.MaxRetries {
+			return txSig, err
+		}
+	}
+}
+
+func (r *Relayer) send(ctx context.Context, instructions []solana.Instruction) (solana.Signature, error) {
+	commitment := r.chain.Meta.Relayer.Commitment
+
+	latest, err := r.chain.Rpc.GetLatestBlockhash(ctx, commitment)
+	if err != nil {
+		return solana.Signature{}, errors.Wrap(err, "failed to get latest blockhash")
+	}
+
+	tx, err := solana.NewTransaction(instructions, latest.Value.Blockhash, solana.TransactionPayer(r.payer.PublicKey()))
+	if err != nil {
+		return solana.Signature{}, errors.Wrap(err, "failed to create transaction")
+	}
+	if _, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		if key.Equals(r.payer.PublicKey()) {
+			return &r.payer
+		}
+		return nil
+	}); err != nil {
+		return solana.Signature{}, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	txSig, err := r.chain.Rpc.SendTransactionWithOpts(ctx, tx, rpc.TransactionOpts{PreflightCommitment: commitment})
+	if err != nil {
+		return solana.Signature{}, errors.Wrap(err, "failed to send transaction")
+	}
+
+	return txSig, r.waitForConfirmation(ctx, txSig, latest.Value.LastValidBlockHeight)
+}
+
+// waitForConfirmation waits until the transaction reaches the configured commitment
+// or its blockhash expires.
+func (r *Relayer) waitForConfirmation(ctx context.Context, txSig solana.Signature, lastValidBlockHeight uint64) error {
+	required := commitmentLevels[rpc.ConfirmationStatusType(r.chain.Meta.Relayer.Commitment)]
+
+	ticker := time.NewTicker(confirmationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "failed to wait for transaction confirmation")
+		case <-ticker.C:
+		}
+
+		status, err := r.signatureStatus(ctx, txSig, false)
+		if err != nil {
+			return errors.Wrap(err, "failed to get transaction status")
+		}
+		if status == nil {
+			height, err := r.chain.Rpc.GetBlockHeight(ctx, rpc.CommitmentConfirmed)
+			if err != nil {
+				return errors.Wrap(err, "failed to get block height")
+			}
+			if height <= lastValidBlockHeight {
+				continue
+			}
+
+			// the transaction could land between the status and the block height requests,
+			// re-sending it would produce the duplicated withdrawal attempt
+			if status, err = r.signatureStatus(ctx, txSig, true); err != nil {
+				return errors.Wrap(err, "failed to get transaction status")
+			}
+			if status == nil {
+				return errBlockhashExpired
+			}
+		}
+
+		if status.Err != nil {
+			return errors.Wrap(bridgeTypes.ErrTxFailed, fmt.Sprintf("%v", status.Err))
+		}
+		if commitmentLevels[status.ConfirmationStatus] >= required {
+			return nil
+		}
+		// the landed transaction can not expire
+	}
+}
+
+// signatureStatus returns the transaction status or nil if the transaction is not found.
+func (r *Relayer) signatureStatus(ctx context.Context, txSig solana.Signature, searchHistory bool) (*rpc.SignatureStatusesResult, error) {
+	statuses, err := r.chain.Rpc.GetSignatureStatuses(ctx, searchHistory, txSig)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses.Value) == 0 {
+		return nil, nil
+	}
+
+	return statuses.Value[0], nil
+}
+
+func (r *Relayer) withdrawalInstructions(ctx context.Context, deposit db.Deposit, signature []byte) ([]solana.Instruction, error) {
+	if len(signature) != 65 {
+		return nil, errors.New("invalid signature length")
+	}
+	amount, err := strconv.ParseUint(deposit.WithdrawalAmount, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse withdrawal amount")
+	}
+	receiver, err := solana.PublicKeyFromBase58(deposit.Receiver)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse receiver address")
+	}
+	signHash, err := r.client.GetSignHash(deposit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sign hash")
+	}
+
+	var (
+		bridgeId   = r.chain.Meta.BridgeId
+		hash       = [32]byte(signHash)
+		uid        = depositUid(deposit)
+		sig        = [64]byte(signature[:64])
+		recoveryId = signature[64]
+		signer     = r.payer.PublicKey()
+	)
+
+	var instructions []solana.Instruction
+	if deposit.WithdrawalToken == bridge.DefaultNativeTokenAddress {
+		builder := contract.NewWithdrawNativeInstructionBuilder()
+		authority, _, err := builder.FindAuthorityAddress(bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find authority address")
+		}
+		txUsed, _, err := builder.FindTxUsedAddress(hash, bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find tx used address")
+		}
+
+		return append(instructions, contract.NewWithdrawNativeInstruction(
+			bridgeId, hash, amount, uid, sig, recoveryId,
+			receiver, authority, txUsed, signer, solana.SystemProgramID,
+		).Build()), nil
+	}
+
+	mint, err := solana.PublicKeyFromBase58(deposit.WithdrawalToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse withdrawal token address")
+	}
+	receiverAccount, createAccount, err := r.receiverTokenAccount(ctx, receiver, mint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get receiver token account")
+	}
+	if createAccount != nil {
+		instructions = append(instructions, createAccount)
+	}
+
+	if !deposit.IsWrappedToken {
+		builder := contract.NewWithdrawSplInstructionBuilder()
+		vault, _, err := builder.FindSplVaultAddress(mint, bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find spl vault address")
+		}
+		authority, _, err := builder.FindAuthorityAddress(bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find authority address")
+		}
+		txUsed, _, err := builder.FindTxUsedAddress(hash, bridgeId)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find tx used address")
+		}
+
+		return append(instructions, contract.NewWithdrawSplInstruction(
+			bridgeId, hash, amount, uid, sig, recoveryId,
+			mint, vault, receiverAccount, authority, txUsed, signer, solana.SystemProgramID, solana.TokenProgramID,
+		).Build()), nil
+	}
+
+	wrapped, ok := r.wrappedMints[mint]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("wrapped mint %s is not configured", mint))
+	}
+	builder := contract.NewWithdrawWrappedInstructionBuilder()
+	authority, _, err := builder.FindAuthorityAddress(bridgeId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find authority address")
+	}
+	txUsed, _, err := builder.FindTxUsedAddress(hash, bridgeId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find tx used address")
+	}
+
+	return append(instructions, contract.NewWithdrawWrappedInstruction(
+		bridgeId, hash, wrapped.MintNonce, wrapped.Symbol, amount, uid, sig, recoveryId,
+		mint, receiverAccount, authority, txUsed, signer, solana.SystemProgramID, solana.TokenProgramID,
+	).Build()), nil
+}
+
+// receiverTokenAccount returns the associated token account of the receiver
+// and the instruction creating it if the account does not exist yet.
+func (r *Relayer) receiverTokenAccount(ctx context.Context, receiver, mint solana.PublicKey) (solana.PublicKey, solana.Instruction, error) {
+	account, _, err := solana.FindAssociatedTokenAddress(receiver, mint)
+	if err != nil {
+		return solana.PublicKey{}, nil, errors.Wrap(err, "failed to find associated token address")
+	}
+
+	_, err = r.chain.Rpc.GetAccountInfo(ctx, account)
+	switch {
+	case err == nil:
+		return account, nil, nil
+	case errors.Is(err, rpc.ErrNotFound):
+		return account, associatedtokenaccount.NewCreateInstruction(r.payer.PublicKey(), receiver, mint).Build(), nil
+	default:
+		return solana.PublicKey{}, nil, errors.Wrap(err, "failed to get account info")
+	}
+}
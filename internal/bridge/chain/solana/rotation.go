@@ -0,0 +1,111 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+var _ chain.SignerRotator = &SignerRotator{}
+
+// SignerRotator changes the bridge authority to the new TSS key via the change_authority instruction.
+type SignerRotator struct {
+	client *Client
+	// relayer is required for the session leader only
+	relayer *Relayer
+	newKey  [33]byte
+}
+
+func NewSignerRotator(client *Client, relayer *Relayer, newKey *ecdsa.PublicKey) *SignerRotator {
+	return &SignerRotator{
+		client:  client,
+		relayer: relayer,
+		newKey:  [33]byte(crypto.CompressPubkey(newKey)),
+	}
+}
+
+func (r *SignerRotator) FormRotation(ctx context.Context) (*chain.SignerRotation, error) {
+	authority, err := r.client.Authority(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bridge authority")
+	}
+
+	op := AdminOperation{
+		Action:      AdminActionChangeAuthority,
+		Authorities: [][33]byte{r.newKey},
+		Nonce:       authority.AuthNonce + 1,
+	}
+	payload, err := json.Marshal(op)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode admin operation")
+	}
+	sigData, err := r.client.GetAdminSignHash(op)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get admin sign hash")
+	}
+
+	return &chain.SignerRotation{
+		Payload: payload,
+		SigData: [][]byte{sigData},
+	}, nil
+}
+
+func (r *SignerRotator) VerifyRotation(ctx context.Context, rotation chain.SignerRotation) error {
+	expected, err := r.FormRotation(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to form local rotation")
+	}
+
+	if !bytes.Equal(expected.Payload, rotation.Payload) {
+		return errors.New("proposed operation does not match the local one")
+	}
+	if len(rotation.SigData) != 1 || !bytes.Equal(expected.SigData[0], rotation.SigData[0]) {
+		return errors.New("sign data does not match the expected one")
+	}
+
+	return nil
+}
+
+func (r *SignerRotator) SubmitRotation(ctx context.Context, rotation chain.SignerRotation, signatures [][]byte) (string, error) {
+	if r.relayer == nil {
+		return "", errors.New("relayer key is required to submit the instruction")
+	}
+	if len(signatures) != 1 {
+		return "", errors.New("invalid signatures count")
+	}
+
+	var op AdminOperation
+	if err := json.Unmarshal(rotation.Payload, &op); err != nil {
+		return "", errors.Wrap(err, "failed to decode admin operation")
+	}
+	instruction, err := r.client.AdminInstruction(op, signatures[0], r.relayer.Address())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build change authority instruction")
+	}
+
+	txSig, err := r.relayer.Send(ctx, instruction)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send change authority transaction")
+	}
+
+	return txSig.String(), nil
+}
+
+func (r *SignerRotator) VerifyRotated(ctx context.Context) error {
+	authority, err := r.client.Authority(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get bridge authority")
+	}
+
+	if len(authority.Signers) != 1 || authority.Signers[0] != r.newKey {
+		return errors.New(fmt.Sprintf("bridge authority has %d signers, expected the new key only", len(authority.Signers)))
+	}
+
+	return nil
+}
@@ -28,10 +28,7 @@ func (p *Client) GetSignHash(data db.Deposit) ([]byte, error) {
 	amountBytes := make([]byte, 8)
 	binary.LittleEndian.PutUint64(amountBytes, amount)
 
-	nonceBytes := make([]byte, 8)
-	binary.LittleEndian.PutUint64(nonceBytes, uint64(data.TxNonce))
-	// unique id derived from deposit info
-	uid := sha256.Sum256(append([]byte(data.TxHash), nonceBytes...))
+	uid := depositUid(data)
 
 	receiver, err := solana.PublicKeyFromBase58(data.Receiver)
 	if err != nil {
@@ -55,3 +52,11 @@ func (p *Client) GetSignHash(data db.Deposit) ([]byte, error) {
 	hash := sha256.Sum256(buffer)
 	return hash[:], nil
 }
+
+// depositUid is the unique withdrawal id derived from the deposit info.
+func depositUid(data db.Deposit) [32]byte {
+	nonceBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nonceBytes, uint64(data.TxNonce))
+
+	return sha256.Sum256(append([]byte(data.TxHash), nonceBytes...))
+}
@@ -24,8 +24,48 @@ type Chain struct {
 	Client                ton.APIClientWrapped
 	BridgeContractAddress *address.Address
 	RPC                   RPC
+
+	Meta Meta
+}
+
+type Meta struct {
+	Relayer  RelayerSettings  `fig:"relayer"`
+	Rotation RotationSettings `fig:"rotation"`
+}
+
+type RelayerSettings struct {
+	// Enabled defines whether the signed withdrawals should be sent
+	// to the bridge contract by the session leader
+	Enabled bool `fig:"enabled"`
+	// WalletVersion is the version of the relayer wallet contract: v3r2, v4r2 or v5r1
+	WalletVersion string `fig:"wallet_version"`
+	// MessageValue is the amount of TON attached to the withdrawal message to cover the bridge contract fees
+	MessageValue string `fig:"message_value"`
+	// WithdrawNativeOpCode and WithdrawJettonOpCode are the bridge contract withdrawal message op codes
+	WithdrawNativeOpCode uint32 `fig:"withdraw_native_op_code"`
+	WithdrawJettonOpCode uint32 `fig:"withdraw_jetton_op_code"`
+	// ConfirmTimeout is the maximum time to wait for the withdrawal message
+	// to be sent by the wallet and processed by the bridge contract
+	ConfirmTimeout time.Duration `fig:"confirm_timeout"`
+}
+
+// RotationSettings describe the bridge contract signer change used during the key resharing.
+type RotationSettings struct {
+	// ChangeSignerOpCode is the op code of the message setting the new bridge signer
+	ChangeSignerOpCode uint32 `fig:"change_signer_op_code"`
+	// SignerHashMethod is the getter returning the hash to be signed by the current signer,
+	// it accepts the new signer address
+	SignerHashMethod string `fig:"signer_hash_method"`
+	// SignerMethod is the getter returning the current signer address
+	SignerMethod string `fig:"signer_method"`
 }
 
+const (
+	defaultWalletVersion  = "v4r2"
+	defaultMessageValue   = "0.1"
+	defaultConfirmTimeout = 2 * time.Minute
+)
+
 func FromChain(c chain.Chain) Chain {
 	if c.Type != chain.TypeTON {
 		panic("chain is not TON")
@@ -50,6 +90,19 @@ func FromChain(c chain.Chain) Chain {
 		panic(errors.Wrap(err, "failed to obtain TON rpc"))
 	}
 
+	if err = figure.Out(&tonChain.Meta).FromInterface(c.Meta).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to decode chain meta"))
+	}
+	if tonChain.Meta.Relayer.WalletVersion == "" {
+		tonChain.Meta.Relayer.WalletVersion = defaultWalletVersion
+	}
+	if tonChain.Meta.Relayer.MessageValue == "" {
+		tonChain.Meta.Relayer.MessageValue = defaultMessageValue
+	}
+	if tonChain.Meta.Relayer.ConfirmTimeout == 0 {
+		tonChain.Meta.Relayer.ConfirmTimeout = defaultConfirmTimeout
+	}
+
 	return tonChain
 }
 
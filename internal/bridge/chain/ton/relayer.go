@@ -0,0 +1,212 @@
+package ton
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/pkg/errors"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/ton/wallet"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+const (
+	signatureSizeBit = 65 * 8
+	// bridgeTxPollInterval is the interval between the lookups of the bridge transaction processing the sent message
+	bridgeTxPollInterval = 5 * time.Second
+)
+
+// Relayer sends the signed withdrawals to the bridge contract from the configured wallet.
+type Relayer struct {
+	client *Client
+	wallet *wallet.Wallet
+	value  tlb.Coins
+}
+
+// NewRelayer creates the relayer sending the messages via the client liteclient pool,
+// the raw key is the space-separated wallet mnemonic.
+func NewRelayer(client *Client, rawKey string) (*Relayer, error) {
+	settings := client.Meta.Relayer
+	if settings.Enabled && (settings.WithdrawNativeOpCode == 0 || settings.WithdrawJettonOpCode == 0) {
+		return nil, errors.New("withdrawal op codes are not configured")
+	}
+
+	value, err := tlb.FromTON(settings.MessageValue)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse message value")
+	}
+
+	version, err := walletVersion(settings.WalletVersion, client.RPC.IsTestnet)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get wallet version")
+	}
+
+	w, err := wallet.FromSeed(client.Client, strings.Fields(rawKey), version)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create relayer wallet")
+	}
+
+	return &Relayer{
+		client: client,
+		wallet: w,
+		value:  value,
+	}, nil
+}
+
+func walletVersion(version string, isTestnet bool) (wallet.VersionConfig, error) {
+	switch version {
+	case "v3r2":
+		return wallet.V3R2, nil
+	case "v4r2":
+		return wallet.V4R2, nil
+	case "v5r1":
+		networkId := int32(wallet.MainnetGlobalID)
+		if isTestnet {
+			networkId = wallet.TestnetGlobalID
+		}
+		return wallet.ConfigV5R1Final{NetworkGlobalID: networkId}, nil
+	default:
+		return nil, errors.New(fmt.Sprintf("unsupported wallet version %q", version))
+	}
+}
+
+func (r *Relayer) Address() string {
+	return r.wallet.Address().String()
+}
+
+// Relay sends the withdrawal message for the given deposit signed with the provided TSS signature
+// and returns the bridge contract transaction that successfully processed it.
+func (r *Relayer) Relay(ctx context.Context, deposit db.Deposit, signature []byte) (*tlb.Transaction, error) {
+	body, err := r.withdrawalBody(deposit, signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build withdrawal message")
+	}
+
+	tx, err := r.Send(ctx, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send withdrawal message")
+	}
+
+	return tx, nil
+}
+
+// Send sends the message with the given body to the bridge contract from the relayer wallet
+// and returns the bridge contract transaction processing it. The wallet transaction succeeds
+// even if the message is rejected by the bridge, so the bridge transaction result is checked as well.
+func (r *Relayer) Send(ctx context.Context, body *cell.Cell) (*tlb.Transaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.client.Meta.Relayer.ConfirmTimeout)
+	defer cancel()
+
+	if _, _, err := r.wallet.SendWaitTransaction(ctx, wallet.SimpleMessage(r.client.BridgeContractAddress, r.value, body)); err != nil {
+		return nil, errors.Wrap(err, "failed to send message")
+	}
+
+	tx, err := r.waitBridgeTransaction(ctx, body.Hash())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bridge transaction")
+	}
+	if err = transactionSucceeded(tx); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("bridge transaction %x (lt %d) failed", tx.Hash, tx.LT))
+	}
+
+	return tx, nil
+}
+
+// waitBridgeTransaction waits for the bridge contract transaction triggered by the message with the given body hash,
+// the internal message is delivered in one of the next blocks after the wallet transaction.
+func (r *Relayer) waitBridgeTransaction(ctx context.Context, bodyHash []byte) (*tlb.Transaction, error) {
+	ticker := time.NewTicker(bridgeTxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		tx, err := r.client.Client.FindLastTransactionByInMsgHash(ctx, r.client.BridgeContractAddress, bodyHash)
+		if err == nil {
+			return tx, nil
+		}
+		if !errors.Is(err, ton.ErrTxWasNotFound) {
+			return nil, errors.Wrap(err, "failed to find bridge transaction")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "bridge transaction was not found")
+		case <-ticker.C:
+		}
+	}
+}
+
+// transactionSucceeded checks that the message was accepted by the contract and not bounced back.
+func transactionSucceeded(tx *tlb.Transaction) error {
+	description, ok := tx.Description.(tlb.TransactionDescriptionOrdinary)
+	if !ok {
+		return errors.New("unexpected transaction type")
+	}
+	if description.Aborted {
+		return errors.New("transaction aborted")
+	}
+	compute, ok := description.ComputePhase.Phase.(tlb.ComputePhaseVM)
+	if !ok {
+		return errors.New("compute phase skipped")
+	}
+	if !compute.Success {
+		return errors.New(fmt.Sprintf("compute phase failed with exit code %d", compute.Details.ExitCode))
+	}
+	if description.ActionPhase != nil && !description.ActionPhase.Success {
+		return errors.New("action phase failed")
+	}
+
+	return nil
+}
+
+// withdrawalBody builds the bridge withdrawal message, the fields follow
+// the arguments of the nativeHash/jettonHash getters the signed hash is obtained from.
+func (r *Relayer) withdrawalBody(deposit db.Deposit, signature []byte) (*cell.Cell, error) {
+	if len(signature) != 65 {
+		return nil, errors.New("invalid signature length")
+	}
+	amount, ok := big.NewInt(0).SetString(deposit.WithdrawalAmount, 10)
+	if !ok {
+		return nil, errors.New("failed to parse withdrawal amount")
+	}
+	receiverCell, err := getAddressCell(deposit.Receiver)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get receiver address cell")
+	}
+	networkCell, err := getNetworkCell(deposit.WithdrawalChainId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the network cell")
+	}
+	signatureCell := cell.BeginCell()
+	if err = signatureCell.StoreSlice(signature, signatureSizeBit); err != nil {
+		return nil, errors.Wrap(err, "failed to store signature")
+	}
+
+	opCode := r.client.Meta.Relayer.WithdrawNativeOpCode
+	if deposit.WithdrawalToken != bridge.DefaultNativeTokenAddress {
+		opCode = r.client.Meta.Relayer.WithdrawJettonOpCode
+	}
+
+	body := cell.BeginCell().
+		MustStoreUInt(uint64(opCode), opCodeBitSize).
+		MustStoreBigInt(amount, amountBitSize).
+		MustStoreRef(receiverCell).
+		MustStoreBigInt(big.NewInt(0).SetBytes(TxHashToBytes32(deposit.TxHash)), amountBitSize).
+		MustStoreBigInt(big.NewInt(0).SetUint64(uint64(deposit.TxNonce)), amountBitSize).
+		MustStoreRef(networkCell)
+
+	if deposit.WithdrawalToken != bridge.DefaultNativeTokenAddress {
+		tokenCell, err := getAddressCell(deposit.WithdrawalToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get withdrawal token address cell")
+		}
+		body = body.MustStoreBoolBit(deposit.IsWrappedToken).MustStoreRef(tokenCell)
+	}
+
+	return body.MustStoreRef(signatureCell.EndCell()).EndCell(), nil
+}
@@ -0,0 +1,127 @@
+package ton
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+const signerBitSize = common.AddressLength * 8
+
+var _ chain.SignerRotator = &SignerRotator{}
+
+// SignerRotator changes the bridge contract signer to the address of the new TSS key,
+// the message layout is defined by the chain rotation settings.
+type SignerRotator struct {
+	client *Client
+	// relayer is required for the session leader only
+	relayer *Relayer
+	signer  *big.Int
+}
+
+func NewSignerRotator(client *Client, relayer *Relayer, newKey *ecdsa.PublicKey) (*SignerRotator, error) {
+	settings := client.Meta.Rotation
+	if settings.ChangeSignerOpCode == 0 || settings.SignerHashMethod == "" || settings.SignerMethod == "" {
+		return nil, errors.New("signer rotation is not configured")
+	}
+
+	return &SignerRotator{
+		client:  client,
+		relayer: relayer,
+		signer:  new(big.Int).SetBytes(crypto.PubkeyToAddress(*newKey).Bytes()),
+	}, nil
+}
+
+// FormRotation forms the change of the signer to the new key address,
+// the payload is the new signer address and the signed hash is obtained from the bridge contract.
+func (r *SignerRotator) FormRotation(ctx context.Context) (*chain.SignerRotation, error) {
+	master, err := r.client.Client.GetMasterchainInfo(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the master chain info")
+	}
+
+	res, err := r.client.Client.RunGetMethod(ctx, master, r.client.BridgeContractAddress, r.client.Meta.Rotation.SignerHashMethod, r.signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the signer hash")
+	}
+	hash, err := res.Int(0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the signer hash")
+	}
+
+	return &chain.SignerRotation{
+		Payload: common.BigToAddress(r.signer).Bytes(),
+		SigData: [][]byte{hash.FillBytes(make([]byte, 32))},
+	}, nil
+}
+
+func (r *SignerRotator) VerifyRotation(ctx context.Context, rotation chain.SignerRotation) error {
+	expected, err := r.FormRotation(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to form local rotation")
+	}
+
+	if !bytes.Equal(expected.Payload, rotation.Payload) {
+		return errors.New("proposed signer does not match the new key")
+	}
+	if len(rotation.SigData) != 1 || !bytes.Equal(expected.SigData[0], rotation.SigData[0]) {
+		return errors.New("sign data does not match the expected one")
+	}
+
+	return nil
+}
+
+func (r *SignerRotator) SubmitRotation(ctx context.Context, rotation chain.SignerRotation, signatures [][]byte) (string, error) {
+	if r.relayer == nil {
+		return "", errors.New("relayer key is required to submit the message")
+	}
+	if len(signatures) != 1 || len(signatures[0]) != 65 {
+		return "", errors.New("invalid signatures")
+	}
+
+	signatureCell := cell.BeginCell()
+	if err := signatureCell.StoreSlice(signatures[0], signatureSizeBit); err != nil {
+		return "", errors.Wrap(err, "failed to store signature")
+	}
+	body := cell.BeginCell().
+		MustStoreUInt(uint64(r.client.Meta.Rotation.ChangeSignerOpCode), opCodeBitSize).
+		MustStoreBigUInt(new(big.Int).SetBytes(rotation.Payload), signerBitSize).
+		MustStoreRef(signatureCell.EndCell()).
+		EndCell()
+
+	tx, err := r.relayer.Send(ctx, body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send change signer message")
+	}
+
+	return fmt.Sprintf("%x (lt %d)", tx.Hash, tx.LT), nil
+}
+
+func (r *SignerRotator) VerifyRotated(ctx context.Context) error {
+	master, err := r.client.Client.GetMasterchainInfo(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the master chain info")
+	}
+
+	res, err := r.client.Client.RunGetMethod(ctx, master, r.client.BridgeContractAddress, r.client.Meta.Rotation.SignerMethod)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the bridge signer")
+	}
+	signer, err := res.Int(0)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse the bridge signer")
+	}
+	if signer.Cmp(r.signer) != 0 {
+		return errors.New(fmt.Sprintf("bridge signer %s does not match the new key", common.BigToAddress(signer)))
+	}
+
+	return nil
+}
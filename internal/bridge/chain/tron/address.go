@@ -0,0 +1,52 @@
+package tron
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// addressPrefix is the first byte of the TRON mainnet addresses, base58check-encoded to the leading T
+const addressPrefix byte = 0x41
+
+// ToEvmAddress converts the base58check-encoded TRON address to the 20-byte account address used by the TVM.
+func ToEvmAddress(addr string) (common.Address, error) {
+	raw, version, err := base58.CheckDecode(addr)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to decode base58check address")
+	}
+	if version != addressPrefix {
+		return common.Address{}, errors.Errorf("invalid address prefix %#x", version)
+	}
+	if len(raw) != common.AddressLength {
+		return common.Address{}, errors.Errorf("invalid address length %d", len(raw))
+	}
+
+	return common.BytesToAddress(raw), nil
+}
+
+// FromEvmAddress encodes the 20-byte TVM account address to the base58check TRON address.
+func FromEvmAddress(addr common.Address) string {
+	return base58.CheckEncode(addr.Bytes(), addressPrefix)
+}
+
+// fromHexAddress converts the hex-encoded address returned by the node API,
+// either 21-byte prefixed or 20-byte one, to the base58check TRON address.
+func fromHexAddress(addr string) (string, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(addr, "0x"))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decode hex address")
+	}
+
+	switch {
+	case len(raw) == common.AddressLength+1 && raw[0] == addressPrefix:
+		raw = raw[1:]
+	case len(raw) != common.AddressLength:
+		return "", errors.Errorf("invalid address length %d", len(raw))
+	}
+
+	return FromEvmAddress(common.BytesToAddress(raw)), nil
+}
@@ -0,0 +1,52 @@
+package tron
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func Test_ToEvmAddress(t *testing.T) {
+	tcs := map[string]struct {
+		address  string
+		expected common.Address
+		valid    bool
+	}{
+		"valid address": {
+			// USDT TRC-20 contract
+			address:  "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+			expected: common.HexToAddress("0xa614f803b6fd780986a42c78ec9c7f77e6ded13c"),
+			valid:    true,
+		},
+		"invalid checksum": {
+			address: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u",
+		},
+		"bitcoin address": {
+			address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		},
+		"hex address": {
+			address: "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			addr, err := ToEvmAddress(tc.address)
+			if !tc.valid {
+				if err == nil {
+					t.Fatalf("expected address %s to be invalid", tc.address)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to convert address: %v", err)
+			}
+			if addr != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected.Hex(), addr.Hex())
+			}
+			if encoded := FromEvmAddress(addr); encoded != tc.address {
+				t.Fatalf("expected %s, got %s", tc.address, encoded)
+			}
+		})
+	}
+}
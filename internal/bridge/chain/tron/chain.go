@@ -0,0 +1,69 @@
+package tron
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/figure/v3"
+)
+
+const defaultRequestTimeout = 30 * time.Second
+
+type Chain struct {
+	Id            string
+	Rpc           *Rpc
+	BridgeAddress string
+	Confirmations uint64
+}
+
+func FromChain(c chain.Chain) Chain {
+	if c.Type != chain.TypeTron {
+		panic("chain is not TRON")
+	}
+
+	chain := Chain{
+		Id:            c.Id,
+		Confirmations: c.Confirmations,
+	}
+
+	if err := figure.Out(&chain.Rpc).FromInterface(c.Rpc).With(rpcHook).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to obtain TRON rpc client"))
+	}
+	if err := figure.Out(&chain.BridgeAddress).FromInterface(c.BridgeAddresses).With(figure.BaseHooks).Please(); err != nil {
+		panic(errors.Wrap(err, "failed to obtain bridge address"))
+	}
+	if _, err := ToEvmAddress(chain.BridgeAddress); err != nil {
+		panic(errors.Wrap(err, "invalid bridge address"))
+	}
+
+	return chain
+}
+
+var rpcHook = figure.Hooks{
+	"*tron.Rpc": func(value interface{}) (reflect.Value, error) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var rpcConfig struct {
+				Host string `fig:"host,required"`
+				// ApiKey is sent in the TRON-PRO-API-KEY header, required by the TronGrid endpoints
+				ApiKey string `fig:"api_key"`
+			}
+
+			if err := figure.Out(&rpcConfig).With(figure.BaseHooks).From(v).Please(); err != nil {
+				return reflect.Value{}, errors.Wrap(err, "failed to figure out TRON rpc config")
+			}
+
+			return reflect.ValueOf(&Rpc{
+				host:   strings.TrimSuffix(rpcConfig.Host, "/"),
+				apiKey: rpcConfig.ApiKey,
+				client: &http.Client{Timeout: defaultRequestTimeout},
+			}), nil
+		default:
+			return reflect.Value{}, errors.Errorf("unsupported conversion from %T", value)
+		}
+	},
+}
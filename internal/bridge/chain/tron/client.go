@@ -0,0 +1,86 @@
+package tron
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
+	v2 "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/contracts/v2"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Client interacts with the TVM port of the EVM bridge contract (v2 ABI).
+type Client struct {
+	chain         Chain
+	bridgeAddress common.Address
+
+	abi             abi.ABI
+	supportedEvents map[common.Hash]string
+}
+
+func NewBridgeClient(chain Chain) *Client {
+	contractAbi, err := abi.JSON(strings.NewReader(v2.BridgeMetaData.ABI))
+	if err != nil {
+		panic(errors.Wrap(err, "failed to parse bridge ABI"))
+	}
+
+	supportedEvents := make(map[common.Hash]string)
+	for _, eventName := range []string{evm.EventNameDepositedNative, evm.EventNameDepositedERC20} {
+		event, ok := contractAbi.Events[eventName]
+		if !ok {
+			panic("required event not found in ABI: " + eventName)
+		}
+		supportedEvents[event.ID] = eventName
+	}
+
+	bridgeAddress, err := ToEvmAddress(chain.BridgeAddress)
+	if err != nil {
+		panic(errors.Wrap(err, "invalid bridge address"))
+	}
+
+	return &Client{
+		chain:           chain,
+		bridgeAddress:   bridgeAddress,
+		abi:             contractAbi,
+		supportedEvents: supportedEvents,
+	}
+}
+
+func (c *Client) Chain() Chain {
+	return c.chain
+}
+
+func (c *Client) ChainId() string {
+	return c.chain.Id
+}
+
+func (c *Client) Type() chain.Type {
+	return chain.TypeTron
+}
+
+// AddressValid checks the address is the base58check-encoded T-address.
+func (c *Client) AddressValid(addr string) bool {
+	_, err := ToEvmAddress(addr)
+	return err == nil
+}
+
+func (c *Client) TransactionHashValid(hash string) bool {
+	return bridge.DefaultTransactionHashPattern.MatchString(hash)
+}
+
+func (c *Client) WithdrawalAmountValid(amount *big.Int) bool {
+	return amount.Cmp(bridge.ZeroAmount) == 1
+}
+
+func (c *Client) HealthCheck() error {
+	if _, err := c.chain.Rpc.BlockNumber(context.Background()); err != nil {
+		return errors.Wrap(err, "failed to check block number")
+	}
+
+	return nil
+}
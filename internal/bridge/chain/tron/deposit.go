@@ -0,0 +1,117 @@
+package tron
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
+	v2 "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm/contracts/v2"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const receiptResultSuccess = "SUCCESS"
+
+func (c *Client) GetDepositData(id db.DepositIdentifier) (*db.DepositData, error) {
+	ctx := context.Background()
+	txId := strings.TrimPrefix(id.TxHash, "0x")
+
+	info, err := c.chain.Rpc.GetTransactionInfo(ctx, txId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transaction info")
+	}
+	if info == nil {
+		return nil, bridgeTypes.ErrTxNotFound
+	}
+	if info.Result != "" || info.Receipt.Result != receiptResultSuccess {
+		return nil, bridgeTypes.ErrTxFailed
+	}
+
+	if id.TxNonce < 0 || int64(len(info.Logs)) < id.TxNonce+1 {
+		return nil, bridgeTypes.ErrDepositNotFound
+	}
+
+	log := info.Logs[id.TxNonce]
+	if common.HexToAddress(log.Address) != c.bridgeAddress {
+		return nil, bridgeTypes.ErrUnsupportedContract
+	}
+	if len(log.Topics) == 0 {
+		return nil, bridgeTypes.ErrDepositNotFound
+	}
+	eventName, ok := c.supportedEvents[common.HexToHash(log.Topics[0])]
+	if !ok {
+		return nil, bridgeTypes.ErrDepositNotFound
+	}
+
+	if err = c.validateConfirmations(ctx, info.BlockNumber); err != nil {
+		return nil, errors.Wrap(err, "failed to validate confirmations")
+	}
+
+	sender, err := c.getSender(ctx, txId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transaction sender")
+	}
+
+	data := common.FromHex(log.Data)
+	depositData := &db.DepositData{
+		DepositIdentifier: id,
+		Block:             int64(info.BlockNumber),
+		SourceAddress:     sender,
+	}
+
+	switch eventName {
+	case evm.EventNameDepositedNative:
+		eventBody := new(v2.BridgeDepositedNative)
+		if err = c.abi.UnpackIntoInterface(eventBody, eventName, data); err != nil {
+			return nil, bridgeTypes.ErrFailedUnpackLogs
+		}
+		depositData.DestinationChainId = eventBody.Network
+		depositData.DestinationAddress = eventBody.Receiver
+		depositData.TokenAddress = bridge.DefaultNativeTokenAddress
+		depositData.DepositAmount = eventBody.Amount
+		depositData.ReferralId = eventBody.ReferralId
+	case evm.EventNameDepositedERC20:
+		eventBody := new(v2.BridgeDepositedERC20)
+		if err = c.abi.UnpackIntoInterface(eventBody, eventName, data); err != nil {
+			return nil, bridgeTypes.ErrFailedUnpackLogs
+		}
+		depositData.DestinationChainId = eventBody.Network
+		depositData.DestinationAddress = eventBody.Receiver
+		depositData.TokenAddress = FromEvmAddress(eventBody.Token)
+		depositData.DepositAmount = eventBody.Amount
+		depositData.ReferralId = eventBody.ReferralId
+	default:
+		return nil, bridgeTypes.ErrUnsupportedEvent
+	}
+
+	return depositData, nil
+}
+
+func (c *Client) getSender(ctx context.Context, txId string) (string, error) {
+	tx, err := c.chain.Rpc.GetTransaction(ctx, txId)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get transaction")
+	}
+	if tx == nil || len(tx.RawData.Contract) == 0 {
+		return "", bridgeTypes.ErrTxNotFound
+	}
+
+	return fromHexAddress(tx.RawData.Contract[0].Parameter.Value.OwnerAddress)
+}
+
+func (c *Client) validateConfirmations(ctx context.Context, txBlock uint64) error {
+	curHeight, err := c.chain.Rpc.BlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get current block number")
+	}
+
+	// including the current block
+	if txBlock+c.chain.Confirmations-1 > curHeight {
+		return bridgeTypes.ErrTxNotConfirmed
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package tron
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Rpc is the minimal client of the TRON full-node HTTP API.
+type Rpc struct {
+	host   string
+	apiKey string
+	client *http.Client
+}
+
+type TransactionInfo struct {
+	Id          string `json:"id"`
+	BlockNumber uint64 `json:"blockNumber"`
+	// Result is set to FAILED for the failed transactions only
+	Result  string `json:"result"`
+	Receipt struct {
+		Result string `json:"result"`
+	} `json:"receipt"`
+	Logs []Log `json:"log"`
+}
+
+type Log struct {
+	// Address is the hex-encoded contract address without the 0x41 prefix
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+type Transaction struct {
+	TxId    string `json:"txID"`
+	RawData struct {
+		Contract []struct {
+			Parameter struct {
+				Value struct {
+					// OwnerAddress is the hex-encoded transaction sender address with the 0x41 prefix
+					OwnerAddress string `json:"owner_address"`
+				} `json:"value"`
+			} `json:"parameter"`
+			Type string `json:"type"`
+		} `json:"contract"`
+	} `json:"raw_data"`
+}
+
+type block struct {
+	BlockId     string `json:"blockID"`
+	BlockHeader struct {
+		RawData struct {
+			Number uint64 `json:"number"`
+		} `json:"raw_data"`
+	} `json:"block_header"`
+}
+
+// GetTransactionInfo returns the execution info of the transaction included into the block.
+// Nil info is returned if the transaction is not found.
+func (r *Rpc) GetTransactionInfo(ctx context.Context, txId string) (*TransactionInfo, error) {
+	var info TransactionInfo
+	if err := r.call(ctx, "/wallet/gettransactioninfobyid", map[string]string{"value": txId}, &info); err != nil {
+		return nil, errors.Wrap(err, "failed to get transaction info")
+	}
+	// empty object is returned for the unknown transactions
+	if info.Id == "" {
+		return nil, nil
+	}
+
+	return &info, nil
+}
+
+// GetTransaction returns the transaction by its identifier.
+// Nil transaction is returned if the transaction is not found.
+func (r *Rpc) GetTransaction(ctx context.Context, txId string) (*Transaction, error) {
+	var tx Transaction
+	if err := r.call(ctx, "/wallet/gettransactionbyid", map[string]string{"value": txId}, &tx); err != nil {
+		return nil, errors.Wrap(err, "failed to get transaction")
+	}
+	if tx.TxId == "" {
+		return nil, nil
+	}
+
+	return &tx, nil
+}
+
+func (r *Rpc) BlockNumber(ctx context.Context) (uint64, error) {
+	var latest block
+	if err := r.call(ctx, "/wallet/getnowblock", nil, &latest); err != nil {
+		return 0, errors.Wrap(err, "failed to get latest block")
+	}
+	if latest.BlockId == "" {
+		return 0, errors.New("latest block not found")
+	}
+
+	return latest.BlockHeader.RawData.Number, nil
+}
+
+func (r *Rpc) call(ctx context.Context, path string, request, result any) error {
+	body := []byte("{}")
+	if request != nil {
+		var err error
+		if body, err = json.Marshal(request); err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.host+path, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if r.apiKey != "" {
+		req.Header.Set("TRON-PRO-API-KEY", r.apiKey)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send request")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(fmt.Sprintf("unexpected response status %d: %s", resp.StatusCode, raw))
+	}
+
+	// node errors are returned with the OK status
+	var apiErr struct {
+		Error string `json:"Error"`
+	}
+	if err = json.Unmarshal(raw, &apiErr); err == nil && apiErr.Error != "" {
+		return errors.New(apiErr.Error)
+	}
+
+	return errors.Wrap(json.Unmarshal(raw, result), "failed to unmarshal response")
+}
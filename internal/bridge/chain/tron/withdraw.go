@@ -0,0 +1,28 @@
+package tron
+
+import (
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/pkg/errors"
+)
+
+// GetSignHash forms the same operation hash as the EVM bridge contract does,
+// the TRON addresses are converted to the 20-byte TVM ones beforehand.
+func (c *Client) GetSignHash(data db.Deposit) ([]byte, error) {
+	receiver, err := ToEvmAddress(data.Receiver)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid receiver address")
+	}
+	data.Receiver = receiver.Hex()
+
+	if data.WithdrawalToken != bridge.DefaultNativeTokenAddress {
+		token, err := ToEvmAddress(data.WithdrawalToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid withdrawal token address")
+		}
+		data.WithdrawalToken = token.Hex()
+	}
+
+	return evm.SignHash(data)
+}
@@ -1,9 +1,11 @@
 package chain
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	"github.com/pkg/errors"
 )
 
@@ -57,6 +59,30 @@ type Client interface {
 	HealthCheck() error
 }
 
+// Wrapper is implemented by the clients composed of the chain-specific ones.
+type Wrapper interface {
+	// Unwrap returns the underlying clients, the primary one goes first.
+	Unwrap() []Client
+}
+
+// Unwrap returns the primary chain-specific client of the possibly wrapped one.
+func Unwrap(client Client) Client {
+	if wrapper, ok := client.(Wrapper); ok {
+		return wrapper.Unwrap()[0]
+	}
+
+	return client
+}
+
+// UnwrapAll returns all the chain-specific clients of the possibly wrapped one.
+func UnwrapAll(client Client) []Client {
+	if wrapper, ok := client.(Wrapper); ok {
+		return wrapper.Unwrap()
+	}
+
+	return []Client{client}
+}
+
 type Repository interface {
 	Clients() map[string]Client
 	Client(chainId string) (Client, error)
@@ -69,10 +95,56 @@ type Chain struct {
 	Confirmations   uint64 `fig:"confirmations,required"`
 	Rpc             any    `fig:"rpc,required"`
 	BridgeAddresses any    `fig:"bridge_addresses,required"`
+	// Curve defines the TSS key used to sign the chain withdrawals
+	Curve tss.Curve `fig:"curve"`
+	// Quorum configures the deposits verification by multiple RPC providers
+	Quorum Quorum `fig:"quorum"`
+	// BatchSize is the number of deposits withdrawn within one signing session by the chains supporting batching,
+	// the protocol maximum is used if not set. Must be the same for all the parties.
+	BatchSize uint64 `fig:"batch_size"`
 
 	Meta any `fig:"meta"`
 }
 
+// Quorum defines the additional RPC providers of the chain, the deposit data is accepted
+// only if Threshold providers including the primary one agree on it.
+// The threshold must be a majority of the providers, so that two different answers cannot both be accepted.
+type Quorum struct {
+	// Rpc are the additional providers configured the same way as the chain Rpc
+	Rpc       []any `fig:"rpc"`
+	Threshold int   `fig:"threshold"`
+}
+
+func (q Quorum) Enabled() bool {
+	return len(q.Rpc) > 0
+}
+
+func (q Quorum) Validate() error {
+	if !q.Enabled() {
+		return nil
+	}
+
+	if providers := len(q.Rpc) + 1; q.Threshold <= providers/2 || q.Threshold > providers {
+		return errors.New(fmt.Sprintf("quorum threshold must be in range [%d, %d]", providers/2+1, providers))
+	}
+
+	return nil
+}
+
+// CurveSupported reports whether withdrawals of the chain type can be signed with the given curve.
+func (c Chain) CurveSupported() bool {
+	switch c.Curve {
+	case tss.CurveSecp256k1:
+		return true
+	case tss.CurveEd25519:
+		// the plugin adapter is responsible for the signature scheme of its chain;
+		// the Solana and TON bridge contracts verify the secp256k1 signatures only
+		return c.Type == TypePlugin
+	default:
+		return false
+	}
+}
+
 type Type string
 
 const (
@@ -81,6 +153,9 @@ const (
 	TypeBitcoin Type = "bitcoin"
 	TypeTON     Type = "ton"
 	TypeSolana  Type = "solana"
+	TypeCosmos  Type = "cosmos"
+	TypeTron    Type = "tron"
+	TypePlugin  Type = "plugin"
 	TypeOther   Type = "other"
 )
 
@@ -91,6 +166,9 @@ var typesMap = map[Type]struct{}{
 	TypeBitcoin: {},
 	TypeTON:     {},
 	TypeSolana:  {},
+	TypeCosmos:  {},
+	TypeTron:    {},
+	TypePlugin:  {},
 }
 
 func (c Type) Validate() error {
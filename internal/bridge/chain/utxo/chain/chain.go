@@ -2,11 +2,13 @@ package chain
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/factory"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/rpc"
 	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/indexer"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/figure/v3"
 )
@@ -28,8 +30,22 @@ type Rpc struct {
 type Meta struct {
 	Network utxotypes.Network `fig:"network,required"`
 	Chain   utxotypes.Chain   `fig:"chain,required"`
+	// AddressType defines the TSS wallet address type used to receive the change and consolidated funds
+	AddressType utxotypes.AddressType `fig:"address_type"`
+	// ReplaceByFee configures the fee bumping of the stuck withdrawal transactions
+	ReplaceByFee ReplaceByFee `fig:"replace_by_fee"`
+	// Indexer configures the discovery of the deposits received by the bridge addresses
+	Indexer indexer.Settings `fig:"indexer"`
 }
 
+type ReplaceByFee struct {
+	Enabled bool `fig:"enabled"`
+	// StuckAfter defines the time after which the unconfirmed withdrawal transaction is considered stuck
+	StuckAfter time.Duration `fig:"stuck_after"`
+}
+
+const DefaultStuckAfter = 3 * time.Hour
+
 func FromChain(c chain.Chain) Chain {
 	if c.Type != chain.TypeBitcoin {
 		panic("invalid chain type")
@@ -40,17 +56,31 @@ func FromChain(c chain.Chain) Chain {
 		Confirmations: c.Confirmations,
 	}
 
+	ch.Meta.AddressType = utxotypes.DefaultAddressType
+	ch.Meta.ReplaceByFee.StuckAfter = DefaultStuckAfter
 	if err := figure.Out(&ch.Meta).FromInterface(c.Meta).Please(); err != nil {
 		panic(errors.Wrap(err, "failed to decode chain meta"))
 	}
-	if err := figure.Out(&ch.Rpc).FromInterface(c.Rpc).With(clientHook(ch.Meta.Chain)).Please(); err != nil {
+	if err := ch.Meta.AddressType.Validate(); err != nil {
+		panic(errors.Wrap(err, "invalid address type"))
+	}
+	if !ch.Meta.Chain.SegwitSupported() && ch.Meta.AddressType != utxotypes.AddressTypeP2pkh {
+		panic(errors.Errorf("address type %s is not supported for %s chain", ch.Meta.AddressType, ch.Meta.Chain))
+	}
+	if ch.Meta.ReplaceByFee.Enabled && ch.Meta.Chain != utxotypes.ChainBtc {
+		panic(errors.Errorf("replace-by-fee is not supported for %s chain", ch.Meta.Chain))
+	}
+	if ch.Meta.ReplaceByFee.StuckAfter <= 0 {
+		panic(errors.New("replace-by-fee stuck_after must be positive"))
+	}
+	if err := figure.Out(&ch.Rpc).FromInterface(c.Rpc).With(clientHook(ch.Meta.Chain, ch.Meta.Network)).Please(); err != nil {
 		panic(errors.Wrap(err, "failed to init bitcoin chain rpc"))
 	}
 	if err := figure.Out(&ch.Receivers).FromInterface(c.BridgeAddresses).Please(); err != nil {
 		panic(errors.Wrap(err, "failed to decode bitcoin receivers"))
 	}
 
-	hlp := factory.NewUtxoHelper(ch.Meta.Chain, ch.Meta.Network)
+	hlp := factory.NewUtxoHelper(ch.Meta.Chain, ch.Meta.Network, ch.Meta.AddressType)
 	for _, addr := range ch.Receivers {
 		if !hlp.AddressValid(addr) {
 			panic(errors.Errorf("invalid receiver address: %s", addr))
@@ -65,7 +95,7 @@ func FromChain(c chain.Chain) Chain {
 	return ch
 }
 
-func clientHook(chain utxotypes.Chain) figure.Hooks {
+func clientHook(chain utxotypes.Chain, network utxotypes.Network) figure.Hooks {
 	return figure.Hooks{
 		"*rpc.Client": func(value interface{}) (reflect.Value, error) {
 			switch v := value.(type) {
@@ -85,6 +115,7 @@ func clientHook(chain utxotypes.Chain) figure.Hooks {
 					User:     clientConfig.User,
 					Password: clientConfig.Pass,
 					Chain:    chain,
+					Network:  network,
 				})
 				if err != nil {
 					return reflect.Value{}, errors.Wrap(err, "failed to create bitcoin rpc client")
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/pkg/errors"
+)
+
+var errDepositAddressesDisabled = errors.New("deposit addresses are not enabled")
+
+func (c *client) WithDepositAddresses(pub *ecdsa.PublicKey, addresses db.DepositAddressesQ) Client {
+	c.tssPub = pub
+	c.depositAddresses = addresses
+	c.depositDecoder.WithDepositAddresses(c.chain.Id, addresses)
+
+	return c
+}
+
+func (c *client) DepositAddressesEnabled() bool {
+	return c.tssPub != nil && c.depositAddresses != nil
+}
+
+// DepositAddress returns the wallet address of the key derived for the receiver on the destination chain.
+func (c *client) DepositAddress(dstChainId, receiver string) (string, error) {
+	key, err := c.depositAddressKey(dstChainId, receiver)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to derive deposit address key")
+	}
+
+	return c.helper.WalletAddress(key.PublicKey), nil
+}
+
+// DepositAddressKey returns the derived key controlling the known deposit address.
+func (c *client) DepositAddressKey(address string) (*tss.KeyDerivation, error) {
+	if c.depositAddresses == nil {
+		return nil, errDepositAddressesDisabled
+	}
+
+	depositAddress, err := c.depositAddresses.New().Get(c.chain.Id, address)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get deposit address")
+	}
+	if depositAddress == nil {
+		return nil, errors.New(fmt.Sprintf("unknown deposit address %s", address))
+	}
+
+	return c.depositAddressKey(depositAddress.DestinationChainId, depositAddress.DestinationAddress)
+}
+
+func (c *client) depositAddressKey(dstChainId, receiver string) (*tss.KeyDerivation, error) {
+	if c.tssPub == nil {
+		return nil, errDepositAddressesDisabled
+	}
+
+	return tss.DeriveChildKey(c.tssPub, tss.DepositKeyPath(dstChainId, receiver))
+}
+
+// ListDepositAddressesUnspent returns the unspent outputs of the known deposit addresses only.
+func (c *client) ListDepositAddressesUnspent() ([]btcjson.ListUnspentResult, error) {
+	if c.depositAddresses == nil {
+		return nil, errDepositAddressesDisabled
+	}
+
+	unspent, err := c.chain.Rpc.Wallet.ListUnspent(c.chain.Confirmations)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list unspent outputs")
+	}
+
+	known, err := c.knownDepositAddresses(unspent)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get known deposit addresses")
+	}
+
+	filtered := make([]btcjson.ListUnspentResult, 0, len(unspent))
+	for _, out := range unspent {
+		if _, exists := known[out.Address]; exists {
+			filtered = append(filtered, out)
+		}
+	}
+
+	return filtered, nil
+}
+
+// WatchAddress imports the address to the wallet to track its unspent outputs.
+func (c *client) WatchAddress(addr string) error {
+	return c.chain.Rpc.Wallet.ImportAddress(addr)
+}
+
+// knownDepositAddresses returns the deposit addresses among the addresses of the unspent outputs.
+func (c *client) knownDepositAddresses(unspent []btcjson.ListUnspentResult) (map[string]struct{}, error) {
+	if c.depositAddresses == nil || len(unspent) == 0 {
+		return nil, nil
+	}
+
+	addresses := make([]string, len(unspent))
+	for i, out := range unspent {
+		addresses[i] = out.Address
+	}
+
+	depositAddresses, err := c.depositAddresses.New().Select(c.chain.Id, addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]struct{}, len(depositAddresses))
+	for _, addr := range depositAddresses {
+		known[addr.Address] = struct{}{}
+	}
+
+	return known, nil
+}
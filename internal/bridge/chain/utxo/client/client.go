@@ -1,7 +1,9 @@
 package client
 
 import (
+	"crypto/ecdsa"
 	"math/big"
+	"sync"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
@@ -9,6 +11,9 @@ import (
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/factory"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/indexer"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
@@ -24,18 +29,36 @@ type Client interface {
 	ListUnspent() ([]btcjson.ListUnspentResult, error)
 	SendSignedTransaction(tx *wire.MsgTx) (string, error)
 	EstimateFeeOrDefault() btcutil.Amount
+	GetTransaction(txHash string) (*btcjson.TxRawResult, error)
+	ReplaceByFee() utxochain.ReplaceByFee
+	IndexerSettings() indexer.Settings
 
 	UtxoHelper() helper.UtxoHelper
+
+	// WithDepositAddresses enables the deposit addresses derived from the TSS public key.
+	// The unspent outputs of the deposit addresses are excluded from ListUnspent then.
+	WithDepositAddresses(pub *ecdsa.PublicKey, addresses db.DepositAddressesQ) Client
+	DepositAddressesEnabled() bool
+	DepositAddress(dstChainId, receiver string) (string, error)
+	DepositAddressKey(address string) (*tss.KeyDerivation, error)
+	ListDepositAddressesUnspent() ([]btcjson.ListUnspentResult, error)
+	WatchAddress(addr string) error
 }
 
 type client struct {
 	chain          utxochain.Chain
 	depositDecoder *DepositDecoder
 	helper         helper.UtxoHelper
+
+	tssPub           *ecdsa.PublicKey
+	depositAddresses db.DepositAddressesQ
+
+	listingMu sync.Mutex
+	listing   *receivedListing
 }
 
 func NewBridgeClient(chain utxochain.Chain) Client {
-	chainHelper := factory.NewUtxoHelper(chain.Meta.Chain, chain.Meta.Network)
+	chainHelper := factory.NewUtxoHelper(chain.Meta.Chain, chain.Meta.Network, chain.Meta.AddressType)
 	return &client{
 		chain:          chain,
 		helper:         chainHelper,
@@ -59,6 +82,14 @@ func (c *client) ConsolidationThreshold() int {
 	return utils.ConsolidationThreshold
 }
 
+func (c *client) ReplaceByFee() utxochain.ReplaceByFee {
+	return c.chain.Meta.ReplaceByFee
+}
+
+func (c *client) IndexerSettings() indexer.Settings {
+	return c.chain.Meta.Indexer
+}
+
 func (c *client) AddressValid(addr string) bool {
 	return c.helper.AddressValid(addr)
 }
@@ -68,7 +99,7 @@ func (c *client) TransactionHashValid(hash string) bool {
 }
 
 func (c *client) WithdrawalAmountValid(amount *big.Int) bool {
-	if amount.Cmp(utils.DustAmount) == -1 {
+	if amount.Cmp(c.helper.DustAmount()) == -1 {
 		return false
 	}
 
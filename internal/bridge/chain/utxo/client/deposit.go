@@ -86,6 +86,9 @@ func (c *client) parseSenderAddress(in btcjson.Vin) (addr string, err error) {
 type DepositDecoder struct {
 	helper          helper.UtxoHelper
 	bridgeAddresses []string
+
+	chainId          string
+	depositAddresses db.DepositAddressesQ
 }
 
 type DepositData struct {
@@ -108,6 +111,14 @@ func NewDepositDecoder(helper helper.UtxoHelper, bridgeAddresses []string) *Depo
 	}
 }
 
+// WithDepositAddresses enables decoding the deposits to the derived deposit addresses of the chain,
+// the destination of such deposits is defined by the address instead of the memo.
+func (d *DepositDecoder) WithDepositAddresses(chainId string, depositAddresses db.DepositAddressesQ) *DepositDecoder {
+	d.chainId = chainId
+	d.depositAddresses = depositAddresses
+	return d
+}
+
 func (d *DepositDecoder) Decode(tx *btcjson.TxRawResult, depositIdx int64) (*DepositData, error) {
 	if depositIdx < 0 {
 		return nil, errors.Wrap(bridgeTypes.ErrInvalidTransactionData, "invalid deposit index")
@@ -117,15 +128,31 @@ func (d *DepositDecoder) Decode(tx *btcjson.TxRawResult, depositIdx int64) (*Dep
 		destinationOutputIdx = depositOutputIdx + 1
 	)
 
-	if depositOutputIdx < 0 || destinationOutputIdx >= len(tx.Vout) {
+	if depositOutputIdx >= len(tx.Vout) {
 		return nil, bridgeTypes.ErrDepositNotFound
 	}
 
-	amount, err := d.decodeDepositOutput(tx.Vout[depositOutputIdx])
+	amount, receiver, err := d.decodeDepositOutput(tx.Vout[depositOutputIdx])
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to decode deposit output")
 	}
 
+	if !d.isBridgeAddress(receiver) {
+		depositMemo, err := d.depositAddressMemo(receiver)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode deposit output")
+		}
+
+		return &DepositData{
+			Amount:      amount,
+			DepositMemo: *depositMemo,
+		}, nil
+	}
+
+	if destinationOutputIdx >= len(tx.Vout) {
+		return nil, bridgeTypes.ErrDepositNotFound
+	}
+
 	depositMemo, err := d.decodeDepositMemoOutput(tx.Vout[destinationOutputIdx])
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to decode destination output")
@@ -137,32 +164,29 @@ func (d *DepositDecoder) Decode(tx *btcjson.TxRawResult, depositIdx int64) (*Dep
 	}, nil
 }
 
-func (d *DepositDecoder) decodeDepositOutput(out btcjson.Vout) (amount *big.Int, err error) {
+// decodeDepositOutput returns the deposited amount and the address it was sent to.
+func (d *DepositDecoder) decodeDepositOutput(out btcjson.Vout) (amount *big.Int, receiver string, err error) {
 	scriptRaw, err := hex.DecodeString(out.ScriptPubKey.Hex)
 	if err != nil {
-		return nil, errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, err.Error())
+		return nil, "", errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, err.Error())
 	}
 	if !d.helper.ScriptSupported(scriptRaw) {
-		return nil, errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, "invalid deposit output script")
+		return nil, "", errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, "invalid deposit output script")
 	}
 
 	addresses, err := d.helper.ExtractScriptAddresses(scriptRaw)
 	if err != nil {
-		return nil, errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, err.Error())
+		return nil, "", errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, err.Error())
 	}
 	if len(addresses) != 1 {
-		return nil, errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, "expected exactly one address in deposit output")
-	}
-
-	if !d.isBridgeAddress(addresses[0]) {
-		return nil, errors.Wrap(bridgeTypes.ErrInvalidReceiverAddress, "deposit output address is not a bridge address")
+		return nil, "", errors.Wrap(bridgeTypes.ErrInvalidScriptPubKey, "expected exactly one address in deposit output")
 	}
 
 	if out.Value == 0 {
-		return nil, bridgeTypes.ErrInvalidDepositedAmount
+		return nil, "", bridgeTypes.ErrInvalidDepositedAmount
 	}
 
-	return big.NewInt(utils.ToUnits(out.Value)), nil
+	return big.NewInt(utils.ToUnits(out.Value)), addresses[0], nil
 }
 
 func (d *DepositDecoder) isBridgeAddress(addr string) bool {
@@ -174,6 +198,32 @@ func (d *DepositDecoder) isBridgeAddress(addr string) bool {
 	return false
 }
 
+// isBridgeOutput checks if the output sends funds to one of the bridge addresses.
+func (d *DepositDecoder) isBridgeOutput(out btcjson.Vout) bool {
+	_, receiver, err := d.decodeDepositOutput(out)
+	return err == nil && d.isBridgeAddress(receiver)
+}
+
+// depositAddressMemo returns the destination the derived deposit address was created for.
+func (d *DepositDecoder) depositAddressMemo(addr string) (*DepositMemo, error) {
+	if d.depositAddresses == nil {
+		return nil, errors.Wrap(bridgeTypes.ErrInvalidReceiverAddress, "deposit output address is not a bridge address")
+	}
+
+	depositAddress, err := d.depositAddresses.New().Get(d.chainId, addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get deposit address")
+	}
+	if depositAddress == nil {
+		return nil, errors.Wrap(bridgeTypes.ErrInvalidReceiverAddress, "deposit output address is neither a bridge nor a deposit address")
+	}
+
+	return &DepositMemo{
+		Address: depositAddress.DestinationAddress,
+		ChainId: depositAddress.DestinationChainId,
+	}, nil
+}
+
 func (d *DepositDecoder) decodeDepositMemoOutput(out btcjson.Vout) (*DepositMemo, error) {
 	scriptRaw, err := hex.DecodeString(out.ScriptPubKey.Hex)
 	if err != nil {
@@ -1,11 +1,19 @@
 package client
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
 	"testing"
 
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/factory"
+	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
 	"github.com/Bridgeless-Project/tss-svc/pkg/encoding"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mr-tron/base58"
 )
@@ -171,3 +179,50 @@ func Test_DecodeDepositMemo_V2(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsBridgeOutput(t *testing.T) {
+	bridgeAddr, _ := btcutil.NewAddressPubKeyHash(bytes.Repeat([]byte{0x01}, 20), &chaincfg.TestNet3Params)
+	otherAddr, _ := btcutil.NewAddressPubKeyHash(bytes.Repeat([]byte{0x02}, 20), &chaincfg.TestNet3Params)
+	bridgeScript, _ := txscript.PayToAddrScript(bridgeAddr)
+	otherScript, _ := txscript.PayToAddrScript(otherAddr)
+	memoScript, _ := txscript.NullDataScript([]byte("0xbeefD475A76Ec312502ba7B566a9B4CEA91ab030#123"))
+
+	output := func(script []byte, value float64) btcjson.Vout {
+		return btcjson.Vout{Value: value, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(script)}}
+	}
+
+	tests := map[string]struct {
+		out      btcjson.Vout
+		expected bool
+	}{
+		"output to the bridge address": {
+			out:      output(bridgeScript, 0.001),
+			expected: true,
+		},
+		"output to another address": {
+			out:      output(otherScript, 0.001),
+			expected: false,
+		},
+		"memo output": {
+			out:      output(memoScript, 0),
+			expected: false,
+		},
+		"zero output to the bridge address": {
+			out:      output(bridgeScript, 0),
+			expected: false,
+		},
+	}
+
+	decoder := NewDepositDecoder(
+		factory.NewUtxoHelper(utxotypes.ChainBtc, utxotypes.NetworkTestnet3, utxotypes.AddressTypeP2pkh),
+		[]string{bridgeAddr.EncodeAddress()},
+	)
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := decoder.isBridgeOutput(tc.out); got != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/indexer"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/pkg/errors"
+)
+
+const walletCategoryReceive = "receive"
+
+var _ indexer.Scanner = &client{}
+
+func (c *client) ConfirmedHeight(_ context.Context) (int64, error) {
+	count, err := c.chain.Rpc.Node.GetBlockCount()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get block count")
+	}
+
+	// the block at the tip has one confirmation
+	return count - int64(max(c.chain.Confirmations, 1)) + 1, nil
+}
+
+// ScanDeposits looks for the deposits among the wallet transactions received by the bridge addresses
+// in the given block range. Every output to the bridge address followed by a valid memo is a deposit,
+// the deposit nonce is the index of the output.
+func (c *client) ScanDeposits(ctx context.Context, from, to int64) ([]db.DepositIdentifier, error) {
+	txHashes, err := c.receivedTransactions(ctx, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get received transactions")
+	}
+
+	var identifiers []db.DepositIdentifier
+	for _, txHash := range txHashes {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		tx, err := c.GetTransaction(txHash)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get transaction")
+		}
+
+		for idx, out := range tx.Vout {
+			if !c.depositDecoder.isBridgeOutput(out) {
+				continue
+			}
+			if _, err = c.depositDecoder.Decode(tx, int64(idx)); err != nil {
+				// change and consolidation outputs do not carry the memo
+				continue
+			}
+
+			identifiers = append(identifiers, db.DepositIdentifier{
+				TxHash:  bridge.HexPrefix + tx.Txid,
+				TxNonce: int64(idx),
+				ChainId: c.chain.Id,
+			})
+		}
+	}
+
+	return identifiers, nil
+}
+
+// receivedTransaction is the wallet transaction received by the bridge addresses in the confirmed block.
+type receivedTransaction struct {
+	height int64
+	txHash string
+}
+
+// receivedListing is the result of the single listsinceblock call covering the [from, to] block range,
+// the catching up indexer scans the range batch by batch without listing the wallet transactions again.
+type receivedListing struct {
+	from, to     int64
+	transactions []receivedTransaction
+}
+
+func (l *receivedListing) covers(from, to int64) bool {
+	return l != nil && l.from <= from && to <= l.to
+}
+
+// receivedTransactions returns the hashes of the wallet transactions included in the [from, to] block range
+// that received funds to the bridge addresses.
+func (c *client) receivedTransactions(ctx context.Context, from, to int64) ([]string, error) {
+	c.listingMu.Lock()
+	defer c.listingMu.Unlock()
+
+	if !c.listing.covers(from, to) {
+		listing, err := c.listReceivedTransactions(ctx, from)
+		if err != nil {
+			return nil, err
+		}
+		if !listing.covers(from, to) {
+			return nil, errors.New(fmt.Sprintf("blocks up to %d are not confirmed yet", to))
+		}
+		c.listing = listing
+	}
+
+	var txHashes []string
+	for _, tx := range c.listing.transactions {
+		if tx.height >= from && tx.height <= to {
+			txHashes = append(txHashes, tx.txHash)
+		}
+	}
+
+	return txHashes, nil
+}
+
+// listReceivedTransactions lists the wallet transactions received by the bridge addresses
+// from the given block up to the confirmed height, the transactions in the newer blocks are dropped once.
+func (c *client) listReceivedTransactions(ctx context.Context, from int64) (*receivedListing, error) {
+	// the confirmed height is obtained before the listing, so the listed blocks are not older than it
+	confirmed, err := c.ConfirmedHeight(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get confirmed height")
+	}
+
+	sinceBlock := ""
+	if from > 0 {
+		hash, err := c.chain.Rpc.Node.GetBlockHash(from - 1)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get block hash")
+		}
+		sinceBlock = hash
+	}
+
+	received, err := c.chain.Rpc.Wallet.ListSinceBlock(sinceBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list wallet transactions")
+	}
+
+	var (
+		listing = &receivedListing{from: from, to: confirmed}
+		seen    = make(map[string]struct{})
+		heights = make(map[string]int64)
+	)
+	for _, entry := range received.Transactions {
+		if entry.Category != walletCategoryReceive || entry.BlockHash == "" || !c.depositDecoder.isBridgeAddress(entry.Address) {
+			continue
+		}
+		if _, ok := seen[entry.TxID]; ok {
+			continue
+		}
+
+		// block height is not reported by the older nodes
+		height, ok := heights[entry.BlockHash]
+		if !ok {
+			if entry.BlockHeight != nil {
+				height = int64(*entry.BlockHeight)
+			} else {
+				block, err := c.chain.Rpc.Node.GetBlockVerbose(entry.BlockHash)
+				if err != nil {
+					return nil, errors.Wrap(err, "failed to get block")
+				}
+				height = block.Height
+			}
+			heights[entry.BlockHash] = height
+		}
+		if height < from || height > confirmed {
+			continue
+		}
+
+		seen[entry.TxID] = struct{}{}
+		listing.transactions = append(listing.transactions, receivedTransaction{height: height, txHash: entry.TxID})
+	}
+
+	return listing, nil
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
 	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
-	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
@@ -39,15 +38,17 @@ func (c *client) LockOutputs(tx *wire.MsgTx) error {
 }
 
 func (c *client) EstimateFeeOrDefault() btcutil.Amount {
+	minFee, maxFee := c.helper.MinFeeRate(), c.helper.MaxFeeRate()
+
 	fee, err := c.chain.Rpc.Node.EstimateFee()
 	switch {
 	case err != nil:
 		// TODO: warn about the error
-		return utils.DefaultFeeRateBtcPerKvb
-	case fee < utils.DefaultFeeRateBtcPerKvb:
-		return utils.DefaultFeeRateBtcPerKvb
-	case fee > utils.MaxFeeRateBtcPerKvb:
-		return utils.MaxFeeRateBtcPerKvb
+		return minFee
+	case fee < minFee:
+		return minFee
+	case fee > maxFee:
+		return maxFee
 	default:
 		return fee
 	}
@@ -15,8 +15,30 @@ func (c *client) UnspentCount() (int, error) {
 	return len(unspent), nil
 }
 
+// ListUnspent returns the unspent outputs of the TSS wallet addresses,
+// the outputs of the deposit addresses are excluded as they are signed by the derived keys.
 func (c *client) ListUnspent() ([]btcjson.ListUnspentResult, error) {
-	return c.chain.Rpc.Wallet.ListUnspent(c.chain.Confirmations)
+	unspent, err := c.chain.Rpc.Wallet.ListUnspent(c.chain.Confirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	known, err := c.knownDepositAddresses(unspent)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get known deposit addresses")
+	}
+	if len(known) == 0 {
+		return unspent, nil
+	}
+
+	filtered := make([]btcjson.ListUnspentResult, 0, len(unspent))
+	for _, out := range unspent {
+		if _, exists := known[out.Address]; !exists {
+			filtered = append(filtered, out)
+		}
+	}
+
+	return filtered, nil
 }
 
 func (c *client) SendSignedTransaction(tx *wire.MsgTx) (string, error) {
@@ -5,6 +5,7 @@ import (
 	"crypto/elliptic"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	utxohelper "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
@@ -121,19 +122,19 @@ func (b *helper) CalculateSignatureHash(scriptRaw []byte, tx *btcwire.MsgTx, idx
 	return sigHash, nil
 }
 
-func (b *helper) MockSignatureScript(scriptRaw []byte, tx *btcwire.MsgTx, idx int, amt int64) ([]byte, error) {
+func (b *helper) MockSignatureScript(scriptRaw []byte, tx *btcwire.MsgTx, idx int, amt int64) ([]byte, btcwire.TxWitness, error) {
 	if len(scriptRaw) == 0 {
-		return nil, errors.New("script cannot be empty")
+		return nil, nil, errors.New("script cannot be empty")
 	}
 
 	bchWire := wireToBch(tx)
 
 	sigScript, err := bchscript.SignatureScript(bchWire, idx, amt, scriptRaw, sigHashType, b.mockKey, true)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create signature script")
+		return nil, nil, errors.Wrap(err, "failed to create signature script")
 	}
 
-	return sigScript, nil
+	return sigScript, nil, nil
 }
 
 func (b *helper) P2pkhAddress(pk *ecdsa.PublicKey) string {
@@ -145,7 +146,11 @@ func (b *helper) P2pkhAddress(pk *ecdsa.PublicKey) string {
 	return addr.String()
 }
 
-func (b *helper) InjectSignatures(tx *btcwire.MsgTx, signatures []*common.SignatureData, pk *ecdsa.PublicKey) error {
+func (b *helper) WalletAddress(pk *ecdsa.PublicKey) string {
+	return b.P2pkhAddress(pk)
+}
+
+func (b *helper) InjectSignatures(tx *btcwire.MsgTx, _ [][]byte, signatures []*common.SignatureData, pk *ecdsa.PublicKey) error {
 	if len(signatures) != len(tx.TxIn) {
 		return errors.New("signatures count does not match inputs count")
 	}
@@ -224,12 +229,24 @@ func (b *helper) ArrangeOutputs(unspent []btcjson.ListUnspentResult) []btcjson.L
 	return b.outputArranger.ArrangeOutputs(unspent)
 }
 
-func (b *helper) EstimateFee(tx *btcwire.MsgTx, feeRate btcutil.Amount) btcutil.Amount {
+func (b *helper) EstimateFee(tx *btcwire.MsgTx, _ [][]byte, feeRate btcutil.Amount) btcutil.Amount {
 	// suppose all inputs are p2pkh
 	estimatedSize := txsizes.EstimateSerializeSize(len(tx.TxIn), tx.TxOut, false)
 	return btcutil.Amount(txrules.FeeForSerializeSize(bchutil.Amount(feeRate), estimatedSize))
 }
 
+func (b *helper) MinFeeRate() btcutil.Amount {
+	return utils.DefaultFeeRateBtcPerKvb
+}
+
+func (b *helper) MaxFeeRate() btcutil.Amount {
+	return utils.MaxFeeRateBtcPerKvb
+}
+
+func (b *helper) DustAmount() *big.Int {
+	return utils.DustAmount
+}
+
 func outputsToBch(outputs []*btcwire.TxOut) []*bchwire.TxOut {
 	bchOutputs := make([]*bchwire.TxOut, len(outputs))
 	for i, out := range outputs {
@@ -4,8 +4,10 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	utxohelper "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
+	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -24,13 +26,14 @@ import (
 
 type helper struct {
 	chainParams      *btccfg.Params
+	addressType      utxotypes.AddressType
 	supportedScripts map[btcscript.ScriptClass]bool
 	mockKey          *btcec.PrivateKey
 
 	outputArranger utils.OutputArranger
 }
 
-func NewHelper(chainParams *btccfg.Params) utxohelper.UtxoHelper {
+func NewHelper(chainParams *btccfg.Params, addressType utxotypes.AddressType) utxohelper.UtxoHelper {
 	mockedKey, err := btcec.NewPrivateKey()
 	if err != nil {
 		panic(fmt.Sprintf("failed to create mocked private key: %v", err))
@@ -38,17 +41,24 @@ func NewHelper(chainParams *btccfg.Params) utxohelper.UtxoHelper {
 
 	return &helper{
 		chainParams: chainParams,
+		addressType: addressType,
 		mockKey:     mockedKey,
 		supportedScripts: map[btcscript.ScriptClass]bool{
-			btcscript.PubKeyHashTy: true,
+			btcscript.PubKeyHashTy:          true,
+			btcscript.WitnessV0PubKeyHashTy: true,
 		},
 		outputArranger: utils.LargestFirstOutputArranger{},
 	}
 }
 
 func (b *helper) AddressValid(addr string) bool {
-	_, err := btcutil.DecodeAddress(addr, b.chainParams)
-	return err == nil
+	address, err := btcutil.DecodeAddress(addr, b.chainParams)
+	if err != nil {
+		return false
+	}
+
+	// bech32 addresses are decoded for any registered network
+	return address.IsForNet(b.chainParams)
 }
 
 func (b *helper) ScriptSupported(script []byte) bool {
@@ -99,43 +109,72 @@ func (b *helper) PayToAddrScript(addr string) ([]byte, error) {
 	return script, nil
 }
 
-func (b *helper) CalculateSignatureHash(scriptRaw []byte, tx *wire.MsgTx, idx int, _ int64) ([]byte, error) {
+func (b *helper) CalculateSignatureHash(scriptRaw []byte, tx *wire.MsgTx, idx int, amt int64) ([]byte, error) {
 	if len(scriptRaw) == 0 {
 		return nil, errors.New("script cannot be empty")
 	}
 
-	sigHash, err := btcscript.CalcSignatureHash(scriptRaw, btcscript.SigHashAll, tx, idx)
+	if !btcscript.IsPayToWitnessPubKeyHash(scriptRaw) {
+		sigHash, err := btcscript.CalcSignatureHash(scriptRaw, btcscript.SigHashAll, tx, idx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate signature hash")
+		}
+
+		return sigHash, nil
+	}
+
+	// BIP143 signature hash, previous outputs are used only for taproot inputs
+	sigHashes := btcscript.NewTxSigHashes(tx, btcscript.NewCannedPrevOutputFetcher(scriptRaw, amt))
+	sigHash, err := btcscript.CalcWitnessSigHash(scriptRaw, sigHashes, btcscript.SigHashAll, tx, idx, amt)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to calculate signature hash")
+		return nil, errors.Wrap(err, "failed to calculate witness signature hash")
 	}
 
 	return sigHash, nil
 }
 
-func (b *helper) MockSignatureScript(scriptRaw []byte, tx *wire.MsgTx, idx int, _ int64) ([]byte, error) {
+func (b *helper) MockSignatureScript(scriptRaw []byte, tx *wire.MsgTx, idx int, amt int64) ([]byte, wire.TxWitness, error) {
 	if len(scriptRaw) == 0 {
-		return nil, errors.New("script cannot be empty")
+		return nil, nil, errors.New("script cannot be empty")
 	}
 
-	sigScript, err := btcscript.SignatureScript(tx, idx, scriptRaw, btcscript.SigHashAll, b.mockKey, true)
+	if !btcscript.IsPayToWitnessPubKeyHash(scriptRaw) {
+		sigScript, err := btcscript.SignatureScript(tx, idx, scriptRaw, btcscript.SigHashAll, b.mockKey, true)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to create signature script")
+		}
+
+		return sigScript, nil, nil
+	}
+
+	sigHashes := btcscript.NewTxSigHashes(tx, btcscript.NewCannedPrevOutputFetcher(scriptRaw, amt))
+	witness, err := btcscript.WitnessSignature(tx, sigHashes, idx, amt, scriptRaw, btcscript.SigHashAll, b.mockKey, true)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create signature script")
+		return nil, nil, errors.Wrap(err, "failed to create witness")
 	}
 
-	return sigScript, nil
+	return nil, witness, nil
 }
 
-func (b *helper) InjectSignatures(tx *wire.MsgTx, signatures []*common.SignatureData, pk *ecdsa.PublicKey) error {
+func (b *helper) InjectSignatures(tx *wire.MsgTx, prevScripts [][]byte, signatures []*common.SignatureData, pk *ecdsa.PublicKey) error {
 	if len(signatures) != len(tx.TxIn) {
 		return errors.New("signatures count does not match inputs count")
 	}
 
+	compressedPk := crypto.CompressPubkey(pk)
 	for i, sig := range signatures {
 		encodedSig := utils.EncodeSignature(sig, byte(btcscript.SigHashAll))
+
+		if b.isWitnessInput(prevScripts, i) {
+			tx.TxIn[i].SignatureScript = nil
+			tx.TxIn[i].Witness = wire.TxWitness{encodedSig, compressedPk}
+			continue
+		}
+
 		sigScript, err := btcscript.
 			NewScriptBuilder().
 			AddData(encodedSig).
-			AddData(crypto.CompressPubkey(pk)).
+			AddData(compressedPk).
 			Script()
 		if err != nil {
 			return errors.Wrap(err, fmt.Sprintf("failed to create script for input %d", i))
@@ -156,6 +195,23 @@ func (b *helper) P2pkhAddress(pub *ecdsa.PublicKey) string {
 	return addr.String()
 }
 
+func (b *helper) P2wpkhAddress(pub *ecdsa.PublicKey) string {
+	compressed := crypto.CompressPubkey(pub)
+	pubKeyHash := btcutil.Hash160(compressed)
+
+	addr, _ := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, b.chainParams)
+
+	return addr.String()
+}
+
+func (b *helper) WalletAddress(pub *ecdsa.PublicKey) string {
+	if b.addressType == utxotypes.AddressTypeP2wpkh {
+		return b.P2wpkhAddress(pub)
+	}
+
+	return b.P2pkhAddress(pub)
+}
+
 func (b *helper) TxHash(tx *wire.MsgTx) string {
 	if tx == nil {
 		return ""
@@ -202,16 +258,50 @@ func (b *helper) NewUnsignedTransaction(
 		// TODO: handle not enough funds error
 		return nil, errors.Wrap(err, "failed to create unsigned transaction")
 	}
+	// signalling replaceability to be able to bump the fee of the stuck transaction
+	for _, in := range tx.Tx.TxIn {
+		in.Sequence = utils.ReplaceableSequenceNum
+	}
 
 	return tx, nil
 }
 
-func (b *helper) EstimateFee(tx *wire.MsgTx, feeRate btcutil.Amount) btcutil.Amount {
-	// suppose all inputs are p2pkh
-	estimatedSize := txsizes.EstimateVirtualSize(len(tx.TxIn), 0, 0, 0, tx.TxOut, 0)
+func (b *helper) EstimateFee(tx *wire.MsgTx, prevScripts [][]byte, feeRate btcutil.Amount) btcutil.Amount {
+	p2pkh, p2wpkh := 0, 0
+	for i := range tx.TxIn {
+		if b.isWitnessInput(prevScripts, i) {
+			p2wpkh++
+		} else {
+			p2pkh++
+		}
+	}
+
+	estimatedSize := txsizes.EstimateVirtualSize(p2pkh, 0, p2wpkh, 0, tx.TxOut, 0)
 	return txrules.FeeForSerializeSize(feeRate, estimatedSize)
 }
 
+func (b *helper) MinFeeRate() btcutil.Amount {
+	return utils.DefaultFeeRateBtcPerKvb
+}
+
+func (b *helper) MaxFeeRate() btcutil.Amount {
+	return utils.MaxFeeRateBtcPerKvb
+}
+
+func (b *helper) DustAmount() *big.Int {
+	return utils.DustAmount
+}
+
+// isWitnessInput checks whether the input spends the P2WPKH output.
+// If the previous output script is unknown, the input is considered to be of the wallet address type.
+func (b *helper) isWitnessInput(prevScripts [][]byte, idx int) bool {
+	if idx < len(prevScripts) && len(prevScripts[idx]) != 0 {
+		return btcscript.IsPayToWitnessPubKeyHash(prevScripts[idx])
+	}
+
+	return b.addressType == utxotypes.AddressTypeP2wpkh
+}
+
 func (b *helper) changeSource(addr string) (*txauthor.ChangeSource, error) {
 	changeScript, err := b.PayToAddrScript(addr)
 	if err != nil {
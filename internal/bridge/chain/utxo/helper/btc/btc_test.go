@@ -0,0 +1,92 @@
+package btc
+
+import (
+	"testing"
+
+	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	btccfg "github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	btcscript "github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func Test_InjectSignatures(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	hlp := NewHelper(&btccfg.TestNet3Params, utxotypes.AddressTypeP2wpkh)
+
+	p2pkhScript, _ := hlp.PayToAddrScript(hlp.P2pkhAddress(&key.PublicKey))
+	p2wpkhScript, _ := hlp.PayToAddrScript(hlp.WalletAddress(&key.PublicKey))
+	if !btcscript.IsPayToWitnessPubKeyHash(p2wpkhScript) {
+		t.Fatalf("expected wallet address to be p2wpkh")
+	}
+
+	prevScripts := [][]byte{p2pkhScript, p2wpkhScript}
+	amounts := []int64{10000, 20000}
+
+	prevHash, _ := chainhash.NewHashFromStr("05837c626141191c42210876396c846dfd604dc32e219c82c517ebac8bd7d0eb")
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), nil, nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 1), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(29000, p2wpkhScript))
+
+	signatures := make([]*common.SignatureData, len(tx.TxIn))
+	for i := range tx.TxIn {
+		sigHash, err := hlp.CalculateSignatureHash(prevScripts[i], tx, i, amounts[i])
+		if err != nil {
+			t.Fatalf("failed to calculate signature hash for input %d: %v", i, err)
+		}
+		sig, err := crypto.Sign(sigHash, key)
+		if err != nil {
+			t.Fatalf("failed to sign input %d: %v", i, err)
+		}
+
+		signatures[i] = &common.SignatureData{R: sig[:32], S: sig[32:64]}
+	}
+
+	if err = hlp.InjectSignatures(tx, prevScripts, signatures, &key.PublicKey); err != nil {
+		t.Fatalf("failed to inject signatures: %v", err)
+	}
+
+	fetcher := btcscript.NewMultiPrevOutFetcher(nil)
+	for i, in := range tx.TxIn {
+		fetcher.AddPrevOut(in.PreviousOutPoint, wire.NewTxOut(amounts[i], prevScripts[i]))
+	}
+	sigHashes := btcscript.NewTxSigHashes(tx, fetcher)
+
+	for i := range tx.TxIn {
+		engine, err := btcscript.NewEngine(
+			prevScripts[i], tx, i, btcscript.StandardVerifyFlags, nil, sigHashes, amounts[i], fetcher,
+		)
+		if err != nil {
+			t.Fatalf("failed to create script engine for input %d: %v", i, err)
+		}
+		if err = engine.Execute(); err != nil {
+			t.Fatalf("input %d is not valid: %v", i, err)
+		}
+	}
+
+	if len(tx.TxIn[0].Witness) != 0 || len(tx.TxIn[1].SignatureScript) != 0 {
+		t.Fatalf("expected legacy input to have only signature script and witness input to have only witness")
+	}
+}
+
+func Test_EstimateFee(t *testing.T) {
+	legacy := NewHelper(&btccfg.TestNet3Params, utxotypes.AddressTypeP2pkh)
+	segwit := NewHelper(&btccfg.TestNet3Params, utxotypes.AddressTypeP2wpkh)
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(wire.NewTxOut(1000, make([]byte, 22)))
+
+	legacyFee := legacy.EstimateFee(tx, nil, 1000)
+	segwitFee := segwit.EstimateFee(tx, nil, 1000)
+	if segwitFee >= legacyFee {
+		t.Fatalf("expected p2wpkh fee %d to be lower than p2pkh fee %d", segwitFee, legacyFee)
+	}
+}
@@ -0,0 +1,77 @@
+package doge
+
+import (
+	"math/big"
+
+	utxohelper "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/btc"
+	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
+	"github.com/btcsuite/btcd/btcutil"
+	btccfg "github.com/btcsuite/btcd/chaincfg"
+	btcscript "github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+var (
+	MainNetParams = btccfg.Params{
+		Name:             "mainnet",
+		Net:              wire.BitcoinNet(0xc0c0c0c0),
+		DefaultPort:      "22556",
+		PubKeyHashAddrID: 0x1e, // starts with D
+		ScriptHashAddrID: 0x16, // starts with 9 or A
+		PrivateKeyID:     0x9e,
+		HDCoinType:       3,
+	}
+	TestNet3Params = btccfg.Params{
+		Name:             "testnet3",
+		Net:              wire.BitcoinNet(0xfcc1b7dc),
+		DefaultPort:      "44556",
+		PubKeyHashAddrID: 0x71, // starts with n
+		ScriptHashAddrID: 0xc4, // starts with 2
+		PrivateKeyID:     0xf1,
+		HDCoinType:       1,
+	}
+	RegressionNetParams = btccfg.Params{
+		Name:             "regtest",
+		Net:              wire.BitcoinNet(0xdab5bffa),
+		DefaultPort:      "18444",
+		PubKeyHashAddrID: 0x6f, // starts with m or n
+		ScriptHashAddrID: 0xc4, // starts with 2
+		PrivateKeyID:     0xef,
+		HDCoinType:       1,
+	}
+
+	// Dogecoin Core recommends 0.01 DOGE per kilobyte as the minimum fee rate
+	minFeeRate, _ = btcutil.NewAmount(0.01)
+	maxFeeRate, _ = btcutil.NewAmount(1)
+	// outputs below 0.01 DOGE are considered dust by the Dogecoin Core
+	dustAmount = big.NewInt(1_000_000)
+)
+
+// helper shares the transaction format and the legacy signature hashing with the Bitcoin one.
+// Dogecoin does not support the segregated witness, so only the P2PKH scripts are handled.
+type helper struct {
+	utxohelper.UtxoHelper
+}
+
+func NewHelper(chainParams *btccfg.Params) utxohelper.UtxoHelper {
+	return &helper{
+		UtxoHelper: btc.NewHelper(chainParams, utxotypes.AddressTypeP2pkh),
+	}
+}
+
+func (h *helper) ScriptSupported(script []byte) bool {
+	return btcscript.IsPayToPubKeyHash(script)
+}
+
+func (h *helper) MinFeeRate() btcutil.Amount {
+	return minFeeRate
+}
+
+func (h *helper) MaxFeeRate() btcutil.Amount {
+	return maxFeeRate
+}
+
+func (h *helper) DustAmount() *big.Int {
+	return dustAmount
+}
@@ -1,9 +1,13 @@
 package factory
 
 import (
+	"fmt"
+
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/bch"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/btc"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/doge"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/ltc"
 	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
 	btccfg "github.com/btcsuite/btcd/chaincfg"
 	bchcfg "github.com/gcash/bchd/chaincfg"
@@ -12,6 +16,7 @@ import (
 func NewUtxoHelper(
 	chainType utxotypes.Chain,
 	network utxotypes.Network,
+	addressType utxotypes.AddressType,
 ) helper.UtxoHelper {
 	switch chainType {
 	case utxotypes.ChainBtc:
@@ -24,9 +29,13 @@ func NewUtxoHelper(
 		case utxotypes.NetworkTestnet4:
 			// TODO: add support for testnet4
 			panic("testnet4 is not yet supported for BTC")
+		case utxotypes.NetworkRegtest:
+			params = &btccfg.RegressionNetParams
+		case utxotypes.NetworkSignet:
+			params = &btccfg.SigNetParams
 		}
 
-		return btc.NewHelper(params)
+		return btc.NewHelper(params, addressType)
 	case utxotypes.ChainBch:
 		var params *bchcfg.Params
 		switch network {
@@ -36,10 +45,46 @@ func NewUtxoHelper(
 			params = &bchcfg.TestNet3Params
 		case utxotypes.NetworkTestnet4:
 			params = &bchcfg.TestNet4Params
+		case utxotypes.NetworkRegtest:
+			params = &bchcfg.RegressionNetParams
+		default:
+			panic(unsupportedNetwork(chainType, network))
 		}
 
 		return bch.NewHelper(params)
+	case utxotypes.ChainLtc:
+		var params *btccfg.Params
+		switch network {
+		case utxotypes.NetworkMainnet:
+			params = &ltc.MainNetParams
+		case utxotypes.NetworkTestnet4:
+			params = &ltc.TestNet4Params
+		case utxotypes.NetworkRegtest:
+			params = &ltc.RegressionNetParams
+		default:
+			panic(unsupportedNetwork(chainType, network))
+		}
+
+		return ltc.NewHelper(params, addressType)
+	case utxotypes.ChainDoge:
+		var params *btccfg.Params
+		switch network {
+		case utxotypes.NetworkMainnet:
+			params = &doge.MainNetParams
+		case utxotypes.NetworkTestnet3:
+			params = &doge.TestNet3Params
+		case utxotypes.NetworkRegtest:
+			params = &doge.RegressionNetParams
+		default:
+			panic(unsupportedNetwork(chainType, network))
+		}
+
+		return doge.NewHelper(params)
 	}
 
 	panic("unsupported chain subtype")
 }
+
+func unsupportedNetwork(chainType utxotypes.Chain, network utxotypes.Network) string {
+	return fmt.Sprintf("%s network is not supported for %s", network, chainType)
+}
@@ -0,0 +1,109 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+
+	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func Test_WalletAddress(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tcs := map[string]struct {
+		chain       utxotypes.Chain
+		network     utxotypes.Network
+		addressType utxotypes.AddressType
+		prefix      string
+		// foreign is the wallet address type of another bitcoin network which must be rejected
+		foreign        utxotypes.AddressType
+		foreignNetwork utxotypes.Network
+	}{
+		"ltc mainnet p2pkh": {
+			chain:          utxotypes.ChainLtc,
+			network:        utxotypes.NetworkMainnet,
+			addressType:    utxotypes.AddressTypeP2pkh,
+			prefix:         "L",
+			foreign:        utxotypes.AddressTypeP2wpkh,
+			foreignNetwork: utxotypes.NetworkMainnet,
+		},
+		"ltc mainnet p2wpkh": {
+			chain:          utxotypes.ChainLtc,
+			network:        utxotypes.NetworkMainnet,
+			addressType:    utxotypes.AddressTypeP2wpkh,
+			prefix:         "ltc1q",
+			foreign:        utxotypes.AddressTypeP2pkh,
+			foreignNetwork: utxotypes.NetworkMainnet,
+		},
+		"ltc testnet p2wpkh": {
+			chain:          utxotypes.ChainLtc,
+			network:        utxotypes.NetworkTestnet4,
+			addressType:    utxotypes.AddressTypeP2wpkh,
+			prefix:         "tltc1q",
+			foreign:        utxotypes.AddressTypeP2wpkh,
+			foreignNetwork: utxotypes.NetworkTestnet3,
+		},
+		"ltc regtest p2wpkh": {
+			chain:          utxotypes.ChainLtc,
+			network:        utxotypes.NetworkRegtest,
+			addressType:    utxotypes.AddressTypeP2wpkh,
+			prefix:         "rltc1q",
+			foreign:        utxotypes.AddressTypeP2wpkh,
+			foreignNetwork: utxotypes.NetworkRegtest,
+		},
+		"btc regtest p2wpkh": {
+			chain:          utxotypes.ChainBtc,
+			network:        utxotypes.NetworkRegtest,
+			addressType:    utxotypes.AddressTypeP2wpkh,
+			prefix:         "bcrt1q",
+			foreign:        utxotypes.AddressTypeP2wpkh,
+			foreignNetwork: utxotypes.NetworkMainnet,
+		},
+		"btc signet p2wpkh": {
+			chain:          utxotypes.ChainBtc,
+			network:        utxotypes.NetworkSignet,
+			addressType:    utxotypes.AddressTypeP2wpkh,
+			prefix:         "tb1q",
+			foreign:        utxotypes.AddressTypeP2pkh,
+			foreignNetwork: utxotypes.NetworkMainnet,
+		},
+		"doge mainnet p2pkh": {
+			chain:          utxotypes.ChainDoge,
+			network:        utxotypes.NetworkMainnet,
+			addressType:    utxotypes.AddressTypeP2pkh,
+			prefix:         "D",
+			foreign:        utxotypes.AddressTypeP2pkh,
+			foreignNetwork: utxotypes.NetworkMainnet,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			hlp := NewUtxoHelper(tc.chain, tc.network, tc.addressType)
+
+			addr := hlp.WalletAddress(&key.PublicKey)
+			if !strings.HasPrefix(addr, tc.prefix) {
+				t.Fatalf("expected address %s to start with %s", addr, tc.prefix)
+			}
+			if !hlp.AddressValid(addr) {
+				t.Fatalf("expected address %s to be valid", addr)
+			}
+			foreign := NewUtxoHelper(utxotypes.ChainBtc, tc.foreignNetwork, tc.foreign).WalletAddress(&key.PublicKey)
+			if hlp.AddressValid(foreign) {
+				t.Fatalf("expected bitcoin address %s to be invalid", foreign)
+			}
+
+			script, err := hlp.PayToAddrScript(addr)
+			if err != nil {
+				t.Fatalf("failed to create address script: %v", err)
+			}
+			if !hlp.ScriptSupported(script) {
+				t.Fatalf("expected wallet address script to be supported")
+			}
+		})
+	}
+}
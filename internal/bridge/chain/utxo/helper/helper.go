@@ -2,6 +2,7 @@ package helper
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/btcsuite/btcd/btcjson"
@@ -15,6 +16,8 @@ type UtxoHelper interface {
 	RetrieveOpReturnData(script []byte) ([]byte, error)
 
 	P2pkhAddress(pk *ecdsa.PublicKey) string
+	// WalletAddress returns the TSS wallet address of the configured type.
+	WalletAddress(pk *ecdsa.PublicKey) string
 	AddressValid(string) bool
 	ExtractScriptAddresses(scriptRaw []byte) ([]string, error)
 	PayToAddrScript(addr string) ([]byte, error)
@@ -26,10 +29,22 @@ type UtxoHelper interface {
 		changeAddr string,
 	) (*txauthor.AuthoredTx, error)
 	CalculateSignatureHash(scriptRaw []byte, tx *wire.MsgTx, idx int, amt int64) ([]byte, error)
-	MockSignatureScript(scriptRaw []byte, tx *wire.MsgTx, idx int, amt int64) ([]byte, error)
-	EstimateFee(tx *wire.MsgTx, feeRate btcutil.Amount) btcutil.Amount
+	MockSignatureScript(scriptRaw []byte, tx *wire.MsgTx, idx int, amt int64) ([]byte, wire.TxWitness, error)
+	// EstimateFee estimates the fee of the signed transaction.
+	// Inputs without provided previous output scripts are considered to be of the wallet address type.
+	EstimateFee(tx *wire.MsgTx, prevScripts [][]byte, feeRate btcutil.Amount) btcutil.Amount
+	// MinFeeRate and MaxFeeRate define the fee rates per kilobyte accepted for the chain transactions.
+	MinFeeRate() btcutil.Amount
+	MaxFeeRate() btcutil.Amount
+	// DustAmount returns the minimum output amount relayed by the chain nodes.
+	DustAmount() *big.Int
 	ArrangeOutputs(unspent []btcjson.ListUnspentResult) []btcjson.ListUnspentResult
 
-	InjectSignatures(tx *wire.MsgTx, signatures []*common.SignatureData, pk *ecdsa.PublicKey) error
+	InjectSignatures(tx *wire.MsgTx, prevScripts [][]byte, signatures []*common.SignatureData, pk *ecdsa.PublicKey) error
 	TxHash(tx *wire.MsgTx) string
 }
+
+// FeeRateValid checks whether the fee rate is within the limits accepted for the chain.
+func FeeRateValid(h UtxoHelper, fee btcutil.Amount) bool {
+	return fee >= h.MinFeeRate() && fee <= h.MaxFeeRate()
+}
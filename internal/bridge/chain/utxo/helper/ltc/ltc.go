@@ -0,0 +1,94 @@
+package ltc
+
+import (
+	"math/big"
+
+	utxohelper "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper/btc"
+	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
+	"github.com/btcsuite/btcd/btcutil"
+	btccfg "github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+)
+
+var (
+	MainNetParams = btccfg.Params{
+		Name:             "mainnet",
+		Net:              wire.BitcoinNet(0xdbb6c0fb),
+		DefaultPort:      "9333",
+		PubKeyHashAddrID: 0x30, // starts with L
+		ScriptHashAddrID: 0x32, // starts with M
+		PrivateKeyID:     0xb0,
+		Bech32HRPSegwit:  "ltc",
+		HDCoinType:       2,
+	}
+	// TestNet4Params are the Litecoin testnet parameters, the network is named testnet4 by the Litecoin Core
+	TestNet4Params = btccfg.Params{
+		Name:             "testnet4",
+		Net:              wire.BitcoinNet(0xf1c8d2fd),
+		DefaultPort:      "19335",
+		PubKeyHashAddrID: 0x6f, // starts with m or n
+		ScriptHashAddrID: 0x3a, // starts with Q
+		PrivateKeyID:     0xef,
+		Bech32HRPSegwit:  "tltc",
+		HDCoinType:       1,
+	}
+	RegressionNetParams = btccfg.Params{
+		Name:             "regtest",
+		Net:              wire.BitcoinNet(0xdab5bffa),
+		DefaultPort:      "19444",
+		PubKeyHashAddrID: 0x6f, // starts with m or n
+		ScriptHashAddrID: 0x3a, // starts with Q
+		PrivateKeyID:     0xef,
+		Bech32HRPSegwit:  "rltc",
+		HDCoinType:       1,
+	}
+
+	// Litecoin Core relays transactions paying at least 0.00001 LTC per kilobyte,
+	// 0.0001 LTC per kilobyte is used as the wallet default
+	minFeeRate, _ = btcutil.NewAmount(0.0001)
+	maxFeeRate, _ = btcutil.NewAmount(0.01)
+	// the dust relay fee is 10 times higher than the Bitcoin one
+	dustAmount = big.NewInt(5461)
+)
+
+func init() {
+	// registering the networks to make the bech32 addresses decodable
+	for _, params := range []*btccfg.Params{&MainNetParams, &TestNet4Params} {
+		if err := btccfg.Register(params); err != nil {
+			panic(errors.Wrapf(err, "failed to register litecoin %s params", params.Name))
+		}
+	}
+
+	// Litecoin regtest shares the network magic with the Bitcoin one registered by default,
+	// the magic is not used by the helper, so the copy is registered to make the bech32 prefix known
+	regtest := RegressionNetParams
+	regtest.Net = ^regtest.Net
+	if err := btccfg.Register(&regtest); err != nil {
+		panic(errors.Wrap(err, "failed to register litecoin regtest params"))
+	}
+}
+
+// helper shares the transaction format, scripts and signature hashing with the Bitcoin one.
+type helper struct {
+	utxohelper.UtxoHelper
+}
+
+func NewHelper(chainParams *btccfg.Params, addressType utxotypes.AddressType) utxohelper.UtxoHelper {
+	return &helper{
+		UtxoHelper: btc.NewHelper(chainParams, addressType),
+	}
+}
+
+func (h *helper) MinFeeRate() btcutil.Amount {
+	return minFeeRate
+}
+
+func (h *helper) MaxFeeRate() btcutil.Amount {
+	return maxFeeRate
+}
+
+func (h *helper) DustAmount() *big.Int {
+	return dustAmount
+}
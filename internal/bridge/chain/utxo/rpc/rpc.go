@@ -11,7 +11,6 @@ import (
 	"strings"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
-	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
@@ -24,11 +23,13 @@ type Settings struct {
 	User     string
 	Password string
 	Chain    types.Chain
+	Network  types.Network
 }
 
 type Client struct {
-	c     *rpc.Client
-	chain types.Chain
+	c       *rpc.Client
+	chain   types.Chain
+	network types.Network
 }
 
 func NewClient(settings Settings) (*Client, error) {
@@ -48,7 +49,7 @@ func NewClient(settings Settings) (*Client, error) {
 		return nil, errors.Wrap(err, "failed to connect to RPC server")
 	}
 
-	return &Client{c, settings.Chain}, nil
+	return &Client{c, settings.Chain, settings.Network}, nil
 }
 
 //
@@ -64,11 +65,17 @@ func (c *Client) EstimateFee() (btcutil.Amount, error) {
 	switch c.chain {
 	case types.ChainBch:
 		fee, err = c.estimateFeeBch()
-	case types.ChainBtc:
+	case types.ChainBtc, types.ChainLtc:
 		fee, err = c.estimateFeeBtc()
+	case types.ChainDoge:
+		fee, err = c.estimateFeeDoge()
 	default:
 		return 0, errors.Errorf("unsupported chain: %s", c.chain)
 	}
+	if (err != nil || fee <= 0) && !c.network.FeeMarket() {
+		// no transactions to estimate the fee rate from, the minimum relayed one is used then
+		fee, err = c.relayFee()
+	}
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to estimate fee")
 	}
@@ -77,7 +84,8 @@ func (c *Client) EstimateFee() (btcutil.Amount, error) {
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to convert fee to btcutil.Amount")
 	}
-	if amt < utils.DefaultFeeRateBtcPerKvb {
+	// the chain-specific minimum is applied by the caller
+	if amt <= 0 {
 		return 0, errors.New("estimated fee is too low")
 	}
 
@@ -107,6 +115,22 @@ func (c *Client) estimateFeeBtc() (float64, error) {
 	return *result.FeeRate, nil
 }
 
+func (c *Client) estimateFeeDoge() (float64, error) {
+	const confirmationsTarget = 5
+	var result float64
+	// -1 is returned if there is not enough data to estimate the fee
+	err := c.Call(&result, "estimatefee", confirmationsTarget)
+	return result, extractRpcError(err)
+}
+
+func (c *Client) relayFee() (float64, error) {
+	var info struct {
+		RelayFee float64 `json:"relayfee"`
+	}
+	err := c.Call(&info, "getnetworkinfo")
+	return info.RelayFee, extractRpcError(err)
+}
+
 func (c *Client) GetRawTransactionVerbose(txHash string) (*btcjson.TxRawResult, error) {
 	var tx btcjson.TxRawResult
 	err := c.Call(&tx, "getrawtransaction", txHash, true)
@@ -128,10 +152,11 @@ func (c *Client) SendRawTransaction(tx *wire.MsgTx) (string, error) {
 	txHex := hex.EncodeToString(buf.Bytes())
 	var maxFee interface{}
 	switch c.chain {
-	case types.ChainBtc:
+	case types.ChainBtc, types.ChainLtc:
 		// BTC per kVb; default max fee rate
 		maxFee = 0.1
-	case types.ChainBch:
+	case types.ChainBch, types.ChainDoge:
+		// allowhighfees flag
 		maxFee = false
 	}
 
@@ -146,6 +171,12 @@ func (c *Client) GetBlockCount() (int64, error) {
 	return count, extractRpcError(err)
 }
 
+func (c *Client) GetBlockHash(height int64) (string, error) {
+	var hash string
+	err := c.Call(&hash, "getblockhash", height)
+	return hash, extractRpcError(err)
+}
+
 //
 // WALLET METHODS
 //
@@ -157,6 +188,18 @@ func (c *Client) ListUnspent(minConfirm uint64) ([]btcjson.ListUnspentResult, er
 	return unspent, extractRpcError(err)
 }
 
+// ListSinceBlock returns the wallet transactions, including the watch-only ones,
+// included after the given block or all of them if the block hash is empty.
+func (c *Client) ListSinceBlock(blockHash string) (*btcjson.ListSinceBlockResult, error) {
+	const (
+		targetConfirmations = 1
+		includeWatchOnly    = true
+	)
+	var result btcjson.ListSinceBlockResult
+	err := c.Call(&result, "listsinceblock", blockHash, targetConfirmations, includeWatchOnly)
+	return &result, extractRpcError(err)
+}
+
 func (c *Client) GetWalletInfo() (*btcjson.GetWalletInfoResult, error) {
 	var info btcjson.GetWalletInfoResult
 	err := c.Call(&info, "getwalletinfo")
@@ -176,6 +219,50 @@ func (c *Client) LockUnspent(unlock bool, ops []*wire.OutPoint) error {
 	return extractRpcError(err)
 }
 
+// ImportAddress imports the watch-only address to the wallet without rescanning the chain.
+func (c *Client) ImportAddress(addr string) error {
+	switch c.chain {
+	case types.ChainBch, types.ChainDoge:
+		return c.importAddressLegacy(addr)
+	case types.ChainBtc, types.ChainLtc:
+		return c.importAddressDescriptor(addr)
+	default:
+		return errors.Errorf("unsupported chain: %s", c.chain)
+	}
+}
+
+func (c *Client) importAddressLegacy(addr string) error {
+	const rescan = false
+	err := c.Call(nil, "importaddress", addr, "", rescan)
+	return extractRpcError(err)
+}
+
+func (c *Client) importAddressDescriptor(addr string) error {
+	var info struct {
+		Descriptor string `json:"descriptor"`
+	}
+	if err := c.Call(&info, "getdescriptorinfo", fmt.Sprintf("addr(%s)", addr)); err != nil {
+		return errors.Wrap(extractRpcError(err), "failed to get descriptor info")
+	}
+
+	var results []struct {
+		Success bool              `json:"success"`
+		Error   *btcjson.RPCError `json:"error"`
+	}
+	request := []map[string]interface{}{{"desc": info.Descriptor, "timestamp": "now"}}
+	if err := c.Call(&results, "importdescriptors", request); err != nil {
+		return errors.Wrap(extractRpcError(err), "failed to import descriptor")
+	}
+	if len(results) != 1 || !results[0].Success {
+		if len(results) == 1 && results[0].Error != nil {
+			return errors.Wrap(results[0].Error, "descriptor import failed")
+		}
+		return errors.New("descriptor import failed")
+	}
+
+	return nil
+}
+
 func (c *Client) Call(result any, method string, args ...interface{}) error {
 	err := c.c.Call(result, method, args...)
 	return extractRpcError(err)
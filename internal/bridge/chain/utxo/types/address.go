@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/figure/v3"
+)
+
+const DefaultAddressType = AddressTypeP2pkh
+
+var _ figure.Validatable = AddressType("")
+
+// AddressType defines the type of the TSS wallet address derived from the TSS public key.
+type AddressType string
+
+const (
+	AddressTypeP2pkh  AddressType = "p2pkh"
+	AddressTypeP2wpkh AddressType = "p2wpkh"
+)
+
+func (a AddressType) Validate() error {
+	switch a {
+	case AddressTypeP2pkh, AddressTypeP2wpkh:
+		return nil
+	default:
+		return errors.Errorf("invalid address type: %s", a)
+	}
+}
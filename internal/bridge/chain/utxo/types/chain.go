@@ -12,15 +12,22 @@ var _ figure.Validatable = Chain("")
 type Chain string
 
 const (
-	ChainBtc Chain = "btc"
-	ChainBch Chain = "bch"
+	ChainBtc  Chain = "btc"
+	ChainBch  Chain = "bch"
+	ChainLtc  Chain = "ltc"
+	ChainDoge Chain = "doge"
 )
 
 func (s Chain) Validate() error {
 	switch s {
-	case ChainBtc, ChainBch:
+	case ChainBtc, ChainBch, ChainLtc, ChainDoge:
 		return nil
 	default:
 		return errors.Errorf("invalid type: %s", s)
 	}
 }
+
+// SegwitSupported reports whether the chain supports the segregated witness outputs.
+func (s Chain) SegwitSupported() bool {
+	return s == ChainBtc || s == ChainLtc
+}
@@ -15,13 +15,21 @@ const (
 	NetworkMainnet  Network = "mainnet"
 	NetworkTestnet3 Network = "testnet3"
 	NetworkTestnet4 Network = "testnet4"
+	NetworkRegtest  Network = "regtest"
+	NetworkSignet   Network = "signet"
 )
 
 func (n Network) Validate() error {
 	switch n {
-	case NetworkMainnet, NetworkTestnet3, NetworkTestnet4:
+	case NetworkMainnet, NetworkTestnet3, NetworkTestnet4, NetworkRegtest, NetworkSignet:
 		return nil
 	default:
 		return errors.Errorf("invalid network: %s", n)
 	}
 }
+
+// FeeMarket reports whether the network has enough transactions for the node to estimate the fee rate.
+// Regtest and signet nodes usually return no estimation at all.
+func (n Network) FeeMarket() bool {
+	return n != NetworkRegtest && n != NetworkSignet
+}
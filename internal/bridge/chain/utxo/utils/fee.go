@@ -4,8 +4,13 @@ import (
 	"math/big"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
 )
 
+// ReplaceableSequenceNum is the input sequence number
+// signalling the transaction replaceability (BIP-125)
+const ReplaceableSequenceNum = wire.MaxTxInSequenceNum - 2
+
 var (
 	// minimum fee rate is 0.00001 BTC per kilobyte
 	MaxFeeRateBtcPerKvb, _     = btcutil.NewAmount(0.00005)
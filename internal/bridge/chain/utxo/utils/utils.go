@@ -11,14 +11,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-func FeeRateValid(fee btcutil.Amount) bool {
-	if fee < DefaultFeeRateBtcPerKvb || fee > MaxFeeRateBtcPerKvb {
-		return false
-	}
-
-	return true
-}
-
 func EncodeTransaction(tx *wire.MsgTx) string {
 	buf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
 	if err := tx.Serialize(buf); err != nil {
@@ -28,6 +20,20 @@ func EncodeTransaction(tx *wire.MsgTx) string {
 	return hex.EncodeToString(buf.Bytes())
 }
 
+func DecodeTransaction(encoded string) (*wire.MsgTx, error) {
+	raw, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction hex")
+	}
+
+	tx := &wire.MsgTx{}
+	if err = tx.Deserialize(bytes.NewReader(raw)); err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize transaction")
+	}
+
+	return tx, nil
+}
+
 func MapUnspent(unspent []btcjson.ListUnspentResult) map[types.OutPoint]btcjson.ListUnspentResult {
 	unspentMap := make(map[types.OutPoint]btcjson.ListUnspentResult, len(unspent))
 	for _, u := range unspent {
@@ -0,0 +1,208 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bridgetypes "github.com/Bridgeless-Project/bridgeless-core/v12/x/bridge/types"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/core"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+const (
+	defaultBatchSize    = 1000
+	defaultPollInterval = 30 * time.Second
+)
+
+// Scanner is a chain-specific source of the deposits made to the bridge.
+type Scanner interface {
+	ChainId() string
+	// ConfirmedHeight returns the latest block which deposits are considered confirmed.
+	ConfirmedHeight(ctx context.Context) (int64, error)
+	// ScanDeposits returns identifiers of the deposits found in the [from, to] block range.
+	ScanDeposits(ctx context.Context, from, to int64) ([]db.DepositIdentifier, error)
+}
+
+// Fetcher fetches and validates the deposit data by its identifier.
+type Fetcher interface {
+	FetchDeposit(identifier db.DepositIdentifier) (*db.Deposit, error)
+}
+
+// CoreQuerier looks up the deposits already submitted to core.
+type CoreQuerier interface {
+	GetDepositInfo(identifier *types.DepositIdentifier) (*bridgetypes.Transaction, error)
+}
+
+type Settings struct {
+	Enabled bool `fig:"enabled"`
+	// StartBlock is the first block to be scanned if there is no saved cursor for the chain.
+	// If not set, scanning starts from the latest confirmed block.
+	StartBlock   int64         `fig:"start_block"`
+	BatchSize    int64         `fig:"batch_size"`
+	PollInterval time.Duration `fig:"poll_interval"`
+}
+
+// Indexer scans the chain for the bridge deposits by block ranges
+// and saves newly found ones as pending.
+type Indexer struct {
+	scanner  Scanner
+	settings Settings
+
+	fetcher  Fetcher
+	core     CoreQuerier
+	deposits db.DepositsQ
+	cursors  db.BlockCursorsQ
+
+	logger *logan.Entry
+}
+
+func New(
+	scanner Scanner,
+	settings Settings,
+	fetcher Fetcher,
+	core CoreQuerier,
+	deposits db.DepositsQ,
+	cursors db.BlockCursorsQ,
+	logger *logan.Entry,
+) *Indexer {
+	if settings.BatchSize <= 0 {
+		settings.BatchSize = defaultBatchSize
+	}
+	if settings.PollInterval <= 0 {
+		settings.PollInterval = defaultPollInterval
+	}
+
+	return &Indexer{
+		scanner:  scanner,
+		settings: settings,
+		fetcher:  fetcher,
+		core:     core,
+		deposits: deposits,
+		cursors:  cursors,
+		logger:   logger.WithField("chain_id", scanner.ChainId()),
+	}
+}
+
+func (i *Indexer) Run(ctx context.Context) {
+	i.logger.Info("deposit indexer started")
+
+	cooldown := time.Second * 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			i.logger.Info("deposit indexer cancelled")
+			return
+		case <-time.After(cooldown):
+			cooldown = i.settings.PollInterval
+
+			if err := i.index(ctx); err != nil {
+				i.logger.WithError(err).Error("failed to index deposits, will retry later")
+			}
+		}
+	}
+}
+
+func (i *Indexer) index(ctx context.Context) error {
+	height, err := i.scanner.ConfirmedHeight(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get confirmed height")
+	}
+
+	from, err := i.nextBlock(height)
+	if err != nil {
+		return errors.Wrap(err, "failed to get next block to scan")
+	}
+
+	for from <= height {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		to := min(from+i.settings.BatchSize-1, height)
+		identifiers, err := i.scanner.ScanDeposits(ctx, from, to)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to scan deposits in blocks [%d, %d]", from, to))
+		}
+
+		for _, id := range identifiers {
+			// cursor is not moved until all the deposits in range are processed
+			if err = i.processDeposit(id); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("failed to process deposit %s", id))
+			}
+		}
+
+		if err = i.cursors.Upsert(db.BlockCursor{ChainId: i.scanner.ChainId(), Block: to}); err != nil {
+			return errors.Wrap(err, "failed to save block cursor")
+		}
+
+		from = to + 1
+	}
+
+	return nil
+}
+
+func (i *Indexer) nextBlock(height int64) (int64, error) {
+	cursor, err := i.cursors.Get(i.scanner.ChainId())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get block cursor")
+	}
+	if cursor != nil {
+		return cursor.Block + 1, nil
+	}
+	if i.settings.StartBlock > 0 {
+		return i.settings.StartBlock, nil
+	}
+
+	return height, nil
+}
+
+func (i *Indexer) processDeposit(id db.DepositIdentifier) error {
+	logger := i.logger.WithField("deposit", id.String())
+
+	existing, err := i.deposits.Get(id)
+	if err != nil {
+		return errors.Wrap(err, "failed to check if deposit exists")
+	}
+	if existing != nil {
+		return nil
+	}
+
+	// deposit could be already processed by the network without this party
+	coreDeposit, err := i.core.GetDepositInfo(id.ToMsgDepositIdentifier())
+	if err != nil {
+		return errors.Wrap(err, "failed to check deposit on core")
+	}
+	if coreDeposit != nil {
+		return nil
+	}
+
+	dep, err := i.fetcher.FetchDeposit(id)
+	if err != nil {
+		if chain.IsPendingDepositError(err) {
+			return errors.Wrap(err, "deposit is still pending")
+		}
+		if !chain.IsInvalidDepositError(err) && !core.IsInvalidDepositError(err) {
+			return errors.Wrap(err, "failed to fetch deposit")
+		}
+
+		logger.WithError(err).Warn("invalid deposit found")
+		dep = &db.Deposit{
+			DepositIdentifier: id,
+			WithdrawalStatus:  types.WithdrawalStatus_WITHDRAWAL_STATUS_INVALID,
+		}
+	}
+
+	if _, err = i.deposits.Insert(*dep); err != nil && !errors.Is(err, db.ErrAlreadySubmitted) {
+		return errors.Wrap(err, "failed to save deposit")
+	}
+
+	logger.Info("new deposit indexed")
+
+	return nil
+}
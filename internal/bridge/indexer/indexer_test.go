@@ -0,0 +1,252 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	bridgetypes "github.com/Bridgeless-Project/bridgeless-core/v12/x/bridge/types"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+const chainId = "1"
+
+type mockScanner struct {
+	height int64
+	// deposits are the deposits found by the block number
+	deposits map[int64]db.DepositIdentifier
+	scanned  [][2]int64
+}
+
+func (m *mockScanner) ChainId() string {
+	return chainId
+}
+
+func (m *mockScanner) ConfirmedHeight(context.Context) (int64, error) {
+	return m.height, nil
+}
+
+func (m *mockScanner) ScanDeposits(_ context.Context, from, to int64) ([]db.DepositIdentifier, error) {
+	m.scanned = append(m.scanned, [2]int64{from, to})
+
+	var identifiers []db.DepositIdentifier
+	for block := from; block <= to; block++ {
+		if id, ok := m.deposits[block]; ok {
+			identifiers = append(identifiers, id)
+		}
+	}
+
+	return identifiers, nil
+}
+
+type mockCursors struct {
+	db.BlockCursorsQ
+
+	cursor  *db.BlockCursor
+	upserts []int64
+}
+
+func (m *mockCursors) Get(string) (*db.BlockCursor, error) {
+	return m.cursor, nil
+}
+
+func (m *mockCursors) Upsert(cursor db.BlockCursor) error {
+	m.upserts = append(m.upserts, cursor.Block)
+	m.cursor = &cursor
+
+	return nil
+}
+
+type mockDeposits struct {
+	db.DepositsQ
+
+	existing map[db.DepositIdentifier]bool
+	inserted map[db.DepositIdentifier]types.WithdrawalStatus
+}
+
+func (m *mockDeposits) Get(identifier db.DepositIdentifier) (*db.Deposit, error) {
+	if !m.existing[identifier] {
+		return nil, nil
+	}
+
+	return &db.Deposit{DepositIdentifier: identifier}, nil
+}
+
+func (m *mockDeposits) Insert(deposit db.Deposit) (int64, error) {
+	m.inserted[deposit.DepositIdentifier] = deposit.WithdrawalStatus
+
+	return int64(len(m.inserted)), nil
+}
+
+type mockFetcher struct {
+	errs map[db.DepositIdentifier]error
+}
+
+func (m mockFetcher) FetchDeposit(identifier db.DepositIdentifier) (*db.Deposit, error) {
+	if err := m.errs[identifier]; err != nil {
+		return nil, err
+	}
+
+	return &db.Deposit{
+		DepositIdentifier: identifier,
+		WithdrawalStatus:  types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+	}, nil
+}
+
+type mockCore struct {
+	submitted map[db.DepositIdentifier]bool
+}
+
+func (m mockCore) GetDepositInfo(identifier *types.DepositIdentifier) (*bridgetypes.Transaction, error) {
+	id := db.DepositIdentifier{TxHash: identifier.TxHash, TxNonce: identifier.TxNonce, ChainId: identifier.ChainId}
+	if !m.submitted[id] {
+		return nil, nil
+	}
+
+	return &bridgetypes.Transaction{}, nil
+}
+
+func depositAt(block int64) db.DepositIdentifier {
+	return db.DepositIdentifier{TxHash: fmt.Sprintf("0x%x", block), ChainId: chainId}
+}
+
+func Test_Index(t *testing.T) {
+	pending := types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING
+	invalid := types.WithdrawalStatus_WITHDRAWAL_STATUS_INVALID
+
+	tests := map[string]struct {
+		settings  Settings
+		cursor    *db.BlockCursor
+		deposits  []int64
+		existing  []int64
+		onCore    []int64
+		fetchErrs map[int64]error
+
+		scanned  [][2]int64
+		upserts  []int64
+		inserted map[int64]types.WithdrawalStatus
+		err      bool
+	}{
+		"no cursor, no start block": {
+			scanned: [][2]int64{{100, 100}},
+			upserts: []int64{100},
+		},
+		"no cursor, start block": {
+			settings: Settings{StartBlock: 90},
+			deposits: []int64{95},
+			scanned:  [][2]int64{{90, 100}},
+			upserts:  []int64{100},
+			inserted: map[int64]types.WithdrawalStatus{95: pending},
+		},
+		"cursor saved": {
+			settings: Settings{StartBlock: 10},
+			cursor:   &db.BlockCursor{ChainId: chainId, Block: 80},
+			scanned:  [][2]int64{{81, 100}},
+			upserts:  []int64{100},
+		},
+		"cursor at confirmed height": {
+			cursor: &db.BlockCursor{ChainId: chainId, Block: 100},
+		},
+		"range split into batches": {
+			settings: Settings{StartBlock: 1, BatchSize: 40},
+			deposits: []int64{1, 40, 41, 100},
+			scanned:  [][2]int64{{1, 40}, {41, 80}, {81, 100}},
+			upserts:  []int64{40, 80, 100},
+			inserted: map[int64]types.WithdrawalStatus{1: pending, 40: pending, 41: pending, 100: pending},
+		},
+		"pending deposit stops range": {
+			settings:  Settings{StartBlock: 1, BatchSize: 40},
+			deposits:  []int64{10, 50, 60},
+			fetchErrs: map[int64]error{50: chain.ErrTxNotConfirmed},
+			scanned:   [][2]int64{{1, 40}, {41, 80}},
+			upserts:   []int64{40},
+			inserted:  map[int64]types.WithdrawalStatus{10: pending},
+			err:       true,
+		},
+		"fetch failure stops range": {
+			settings:  Settings{StartBlock: 1, BatchSize: 40},
+			deposits:  []int64{50},
+			fetchErrs: map[int64]error{50: errors.New("connection refused")},
+			scanned:   [][2]int64{{1, 40}, {41, 80}},
+			upserts:   []int64{40},
+			err:       true,
+		},
+		"invalid deposit saved": {
+			settings:  Settings{StartBlock: 90},
+			deposits:  []int64{95, 96},
+			fetchErrs: map[int64]error{95: chain.ErrTxFailed},
+			scanned:   [][2]int64{{90, 100}},
+			upserts:   []int64{100},
+			inserted:  map[int64]types.WithdrawalStatus{95: invalid, 96: pending},
+		},
+		"deposit already on core skipped": {
+			settings: Settings{StartBlock: 90},
+			deposits: []int64{95, 96},
+			onCore:   []int64{95},
+			scanned:  [][2]int64{{90, 100}},
+			upserts:  []int64{100},
+			inserted: map[int64]types.WithdrawalStatus{96: pending},
+		},
+		"deposit already saved skipped": {
+			settings: Settings{StartBlock: 90},
+			deposits: []int64{95},
+			existing: []int64{95},
+			scanned:  [][2]int64{{90, 100}},
+			upserts:  []int64{100},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			scanner := &mockScanner{height: 100, deposits: make(map[int64]db.DepositIdentifier)}
+			for _, block := range tc.deposits {
+				scanner.deposits[block] = depositAt(block)
+			}
+			deposits := &mockDeposits{
+				existing: make(map[db.DepositIdentifier]bool),
+				inserted: make(map[db.DepositIdentifier]types.WithdrawalStatus),
+			}
+			for _, block := range tc.existing {
+				deposits.existing[depositAt(block)] = true
+			}
+			core := mockCore{submitted: make(map[db.DepositIdentifier]bool)}
+			for _, block := range tc.onCore {
+				core.submitted[depositAt(block)] = true
+			}
+			fetcher := mockFetcher{errs: make(map[db.DepositIdentifier]error)}
+			for block, err := range tc.fetchErrs {
+				fetcher.errs[depositAt(block)] = err
+			}
+			cursors := &mockCursors{cursor: tc.cursor}
+
+			err := New(scanner, tc.settings, fetcher, core, deposits, cursors, logan.New()).index(context.Background())
+			if err != nil {
+				if !tc.err {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if tc.err {
+				t.Fatal("expected error, got nil")
+			}
+
+			if !reflect.DeepEqual(scanner.scanned, tc.scanned) {
+				t.Fatalf("expected scanned ranges %v, got %v", tc.scanned, scanner.scanned)
+			}
+			if !reflect.DeepEqual(cursors.upserts, tc.upserts) {
+				t.Fatalf("expected cursor updates %v, got %v", tc.upserts, cursors.upserts)
+			}
+
+			expected := make(map[db.DepositIdentifier]types.WithdrawalStatus, len(tc.inserted))
+			for block, status := range tc.inserted {
+				expected[depositAt(block)] = status
+			}
+			if !reflect.DeepEqual(deposits.inserted, expected) {
+				t.Fatalf("expected inserted deposits %v, got %v", expected, deposits.inserted)
+			}
+		})
+	}
+}
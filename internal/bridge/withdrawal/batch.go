@@ -0,0 +1,102 @@
+package withdrawal
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	"github.com/pkg/errors"
+)
+
+type sigHashFunc func(deposit db.Deposit) ([]byte, error)
+
+func formDepositsSigData(deposits []db.Deposit, sigHash sigHashFunc) ([]*p2p.DepositSigData, error) {
+	if len(deposits) == 0 {
+		return nil, errors.New("no deposits provided")
+	}
+
+	result := make([]*p2p.DepositSigData, len(deposits))
+	for i, deposit := range deposits {
+		hash, err := sigHash(deposit)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("failed to get signing hash for deposit %s", deposit.DepositIdentifier))
+		}
+
+		result[i] = &p2p.DepositSigData{
+			DepositId: &types.DepositIdentifier{
+				ChainId: deposit.ChainId,
+				TxHash:  deposit.TxHash,
+				TxNonce: deposit.TxNonce,
+			},
+			SigData: hash,
+		}
+	}
+
+	return result, nil
+}
+
+func validateDepositsSigData(data []*p2p.DepositSigData, deposits []db.Deposit, sigHash sigHashFunc) (bool, error) {
+	if len(data) == 0 || len(data) != len(deposits) {
+		return false, errors.New("proposed deposits count does not match the expected one")
+	}
+
+	for i, deposit := range deposits {
+		if data[i] == nil || toDepositIdentifier(data[i].DepositId) != deposit.DepositIdentifier {
+			return false, errors.New(fmt.Sprintf("unexpected deposit at position %d", i))
+		}
+
+		hash, err := sigHash(deposit)
+		if err != nil {
+			return false, errors.Wrap(err, fmt.Sprintf("failed to get signing hash for deposit %s", deposit.DepositIdentifier))
+		}
+		if !bytes.Equal(data[i].SigData, hash) {
+			return false, errors.New(fmt.Sprintf("sig data does not match the expected one for deposit %s", deposit.DepositIdentifier))
+		}
+	}
+
+	return true, nil
+}
+
+func depositIdentifiers(data []*p2p.DepositSigData) []db.DepositIdentifier {
+	identifiers := make([]db.DepositIdentifier, 0, len(data))
+	for _, d := range data {
+		if d == nil {
+			continue
+		}
+		identifiers = append(identifiers, toDepositIdentifier(d.DepositId))
+	}
+
+	return identifiers
+}
+
+// depositsSigData returns the data to be signed for each of the batched deposits.
+func depositsSigData(data []*p2p.DepositSigData) [][]byte {
+	sigData := make([][]byte, len(data))
+	for i, d := range data {
+		sigData[i] = d.GetSigData()
+	}
+
+	return sigData
+}
+
+func toDepositIdentifier(id *types.DepositIdentifier) db.DepositIdentifier {
+	if id == nil {
+		return db.DepositIdentifier{}
+	}
+
+	return db.DepositIdentifier{
+		ChainId: id.ChainId,
+		TxHash:  id.TxHash,
+		TxNonce: id.TxNonce,
+	}
+}
+
+func singleDeposit(deposits []db.Deposit) (db.Deposit, error) {
+	if len(deposits) != 1 {
+		return db.Deposit{}, errors.New(fmt.Sprintf("exactly one deposit expected, got %d", len(deposits)))
+	}
+
+	return deposits[0], nil
+}
@@ -0,0 +1,66 @@
+package withdrawal
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+)
+
+func testSigHash(deposit db.Deposit) ([]byte, error) {
+	hash := sha256.Sum256([]byte(deposit.DepositIdentifier.String()))
+	return hash[:], nil
+}
+
+func testDeposits() []db.Deposit {
+	return []db.Deposit{
+		{DepositIdentifier: db.DepositIdentifier{TxHash: "0x01", TxNonce: 0, ChainId: "1"}},
+		{DepositIdentifier: db.DepositIdentifier{TxHash: "0x02", TxNonce: 3, ChainId: "1"}},
+	}
+}
+
+func Test_ValidateDepositsSigData(t *testing.T) {
+	deposits := testDeposits()
+	data, err := formDepositsSigData(deposits, testSigHash)
+	if err != nil {
+		t.Fatalf("failed to form deposits sig data: %v", err)
+	}
+
+	identifiers := depositIdentifiers(data)
+	if len(identifiers) != len(deposits) {
+		t.Fatalf("expected %d identifiers, got %d", len(deposits), len(identifiers))
+	}
+	for i := range deposits {
+		if identifiers[i] != deposits[i].DepositIdentifier {
+			t.Fatalf("identifier %d does not match the deposit", i)
+		}
+	}
+
+	tcs := map[string]struct {
+		deposits []db.Deposit
+		valid    bool
+	}{
+		"must accept the same deposits": {
+			deposits: deposits,
+			valid:    true,
+		},
+		"must reject reordered deposits": {
+			deposits: []db.Deposit{deposits[1], deposits[0]},
+		},
+		"must reject missing deposits": {
+			deposits: deposits[:1],
+		},
+		"must reject no deposits": {
+			deposits: nil,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			valid, err := validateDepositsSigData(data, tc.deposits, testSigHash)
+			if valid != tc.valid {
+				t.Fatalf("expected valid %t, got %t (err: %v)", tc.valid, valid, err)
+			}
+		})
+	}
+}
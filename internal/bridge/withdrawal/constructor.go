@@ -7,15 +7,18 @@ import (
 
 type DepositSigningData interface {
 	consensus.SigningData
-	DepositIdentifier() db.DepositIdentifier
+	// DepositIdentifiers returns identifiers of all the deposits included in the signing data
+	// in the same order as they were proposed.
+	DepositIdentifiers() []db.DepositIdentifier
 }
 
 type SigDataFormer[T DepositSigningData] interface {
-	FormSigningData(deposit db.Deposit) (*T, error)
+	FormSigningData(deposits []db.Deposit) (*T, error)
 }
 
 type SigDataValidator[T DepositSigningData] interface {
-	IsValid(data T, deposit db.Deposit) (bool, error)
+	// IsValid checks the signing data against the deposits ordered as returned by DepositIdentifiers.
+	IsValid(data T, deposits []db.Deposit) (bool, error)
 }
 
 type Constructor[T DepositSigningData] interface {
@@ -0,0 +1,131 @@
+package withdrawal
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/cosmos"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	_ DepositSigningData                = CosmosWithdrawalData{}
+	_ Constructor[CosmosWithdrawalData] = &CosmosWithdrawalConstructor{}
+)
+
+type CosmosWithdrawalData struct {
+	ProposalData *p2p.CosmosProposalData
+}
+
+func (c CosmosWithdrawalData) DepositIdentifiers() []db.DepositIdentifier {
+	if c.ProposalData == nil || c.ProposalData.DepositId == nil {
+		return nil
+	}
+
+	return []db.DepositIdentifier{toDepositIdentifier(c.ProposalData.DepositId)}
+}
+
+func (c CosmosWithdrawalData) HashString() string {
+	if c.ProposalData == nil {
+		return ""
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(c.ProposalData)
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// SigData returns the transaction hash signed within the session, withdrawals are not batched.
+func (c CosmosWithdrawalData) SigData() [][]byte {
+	if c.ProposalData == nil {
+		return nil
+	}
+
+	return [][]byte{c.ProposalData.SigData}
+}
+
+// UnsignedTransaction returns the withdrawal transaction the signature is made for.
+func (c CosmosWithdrawalData) UnsignedTransaction() cosmos.UnsignedTransaction {
+	return cosmos.UnsignedTransaction{
+		BodyBytes:     c.ProposalData.BodyBytes,
+		AuthInfoBytes: c.ProposalData.AuthInfoBytes,
+		AccountNumber: c.ProposalData.AccountNumber,
+	}
+}
+
+type CosmosWithdrawalConstructor struct {
+	client *cosmos.Client
+	tssPub *ecdsa.PublicKey
+}
+
+func NewCosmosConstructor(client *cosmos.Client, tssPub *ecdsa.PublicKey) *CosmosWithdrawalConstructor {
+	return &CosmosWithdrawalConstructor{
+		client: client,
+		tssPub: tssPub,
+	}
+}
+
+func (c *CosmosWithdrawalConstructor) FormSigningData(deposits []db.Deposit) (*CosmosWithdrawalData, error) {
+	deposit, err := singleDeposit(deposits)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid deposits to form signing data")
+	}
+
+	tx, err := c.client.WithdrawalTransaction(deposit, c.tssPub)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to form cosmos withdrawal transaction")
+	}
+	sigData, err := c.client.SignHash(*tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transaction sign hash")
+	}
+
+	return &CosmosWithdrawalData{
+		ProposalData: &p2p.CosmosProposalData{
+			DepositId: &types.DepositIdentifier{
+				ChainId: deposit.ChainId,
+				TxHash:  deposit.TxHash,
+				TxNonce: deposit.TxNonce,
+			},
+			BodyBytes:     tx.BodyBytes,
+			AuthInfoBytes: tx.AuthInfoBytes,
+			AccountNumber: tx.AccountNumber,
+			SigData:       sigData,
+		},
+	}, nil
+}
+
+func (c *CosmosWithdrawalConstructor) IsValid(data CosmosWithdrawalData, deposits []db.Deposit) (bool, error) {
+	if data.ProposalData == nil {
+		return false, errors.New("invalid proposal data")
+	}
+
+	expected, err := c.FormSigningData(deposits)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to form expected signing data")
+	}
+
+	if !bytes.Equal(data.ProposalData.BodyBytes, expected.ProposalData.BodyBytes) {
+		return false, errors.New("transaction body does not match the expected one")
+	}
+	if !bytes.Equal(data.ProposalData.AuthInfoBytes, expected.ProposalData.AuthInfoBytes) {
+		return false, errors.New("transaction auth info does not match the expected one")
+	}
+	if data.ProposalData.AccountNumber != expected.ProposalData.AccountNumber {
+		return false, errors.New("account number does not match the expected one")
+	}
+	if !bytes.Equal(data.ProposalData.SigData, expected.ProposalData.SigData) {
+		return false, errors.New("sig data does not match the expected one")
+	}
+
+	return true, nil
+}
@@ -1,14 +1,12 @@
 package withdrawal
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"fmt"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
 	"github.com/Bridgeless-Project/tss-svc/internal/db"
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
-	"github.com/Bridgeless-Project/tss-svc/internal/types"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 )
@@ -21,18 +19,12 @@ type EvmWithdrawalData struct {
 	SignedWithdrawal string
 }
 
-func (e EvmWithdrawalData) DepositIdentifier() db.DepositIdentifier {
-	identifier := db.DepositIdentifier{}
-
-	if e.ProposalData == nil || e.ProposalData.DepositId == nil {
-		return identifier
+func (e EvmWithdrawalData) DepositIdentifiers() []db.DepositIdentifier {
+	if e.ProposalData == nil {
+		return nil
 	}
 
-	identifier.ChainId = e.ProposalData.DepositId.ChainId
-	identifier.TxHash = e.ProposalData.DepositId.TxHash
-	identifier.TxNonce = e.ProposalData.DepositId.TxNonce
-
-	return identifier
+	return depositIdentifiers(e.ProposalData.Deposits)
 }
 
 func (e EvmWithdrawalData) HashString() string {
@@ -48,47 +40,47 @@ func (e EvmWithdrawalData) HashString() string {
 	return fmt.Sprintf("%x", sha256.Sum256(data))
 }
 
-func NewEvmConstructor(client *evm.Client) *EvmWithdrawalConstructor {
+func (e EvmWithdrawalData) SigData() [][]byte {
+	if e.ProposalData == nil {
+		return nil
+	}
+
+	return depositsSigData(e.ProposalData.Deposits)
+}
+
+// EvmSignHasher forms the bridge contract operation hash signed for the deposit withdrawal.
+// Implemented by the clients of the chains running the EVM-compatible bridge contract.
+type EvmSignHasher interface {
+	GetSignHash(data db.Deposit) ([]byte, error)
+}
+
+var _ EvmSignHasher = &evm.Client{}
+
+func NewEvmConstructor(client EvmSignHasher) *EvmWithdrawalConstructor {
 	return &EvmWithdrawalConstructor{
 		client: client,
 	}
 }
 
 type EvmWithdrawalConstructor struct {
-	client *evm.Client
+	client EvmSignHasher
 }
 
-func (c *EvmWithdrawalConstructor) FormSigningData(deposit db.Deposit) (*EvmWithdrawalData, error) {
-	sigHash, err := c.client.GetSignHash(deposit)
+func (c *EvmWithdrawalConstructor) FormSigningData(deposits []db.Deposit) (*EvmWithdrawalData, error) {
+	sigData, err := formDepositsSigData(deposits, c.client.GetSignHash)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get signing hash")
+		return nil, errors.Wrap(err, "failed to form deposits signing data")
 	}
 
 	return &EvmWithdrawalData{
-		ProposalData: &p2p.EvmProposalData{
-			DepositId: &types.DepositIdentifier{
-				ChainId: deposit.ChainId,
-				TxHash:  deposit.TxHash,
-				TxNonce: deposit.TxNonce,
-			},
-			SigData: sigHash,
-		},
+		ProposalData: &p2p.EvmProposalData{Deposits: sigData},
 	}, nil
 }
 
-func (c *EvmWithdrawalConstructor) IsValid(data EvmWithdrawalData, deposit db.Deposit) (bool, error) {
+func (c *EvmWithdrawalConstructor) IsValid(data EvmWithdrawalData, deposits []db.Deposit) (bool, error) {
 	if data.ProposalData == nil {
 		return false, errors.New("invalid proposal data")
 	}
 
-	sigHash, err := c.client.GetSignHash(deposit)
-	if err != nil {
-		return false, errors.Wrap(err, "failed to get signing hash")
-	}
-
-	if !bytes.Equal(data.ProposalData.SigData, sigHash) {
-		return false, errors.New("sig data does not match the expected one")
-	}
-
-	return true, nil
+	return validateDepositsSigData(data.ProposalData.Deposits, deposits, c.client.GetSignHash)
 }
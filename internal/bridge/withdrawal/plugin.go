@@ -0,0 +1,140 @@
+package withdrawal
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/plugin"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	adapterTypes "github.com/Bridgeless-Project/tss-svc/pkg/plugin"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	_ DepositSigningData                = PluginWithdrawalData{}
+	_ Constructor[PluginWithdrawalData] = &PluginWithdrawalConstructor{}
+)
+
+type PluginWithdrawalData struct {
+	ProposalData *p2p.PluginProposalData
+}
+
+func (p PluginWithdrawalData) DepositIdentifiers() []db.DepositIdentifier {
+	if p.ProposalData == nil {
+		return nil
+	}
+
+	identifiers := make([]db.DepositIdentifier, len(p.ProposalData.DepositIds))
+	for i, id := range p.ProposalData.DepositIds {
+		identifiers[i] = toDepositIdentifier(id)
+	}
+
+	return identifiers
+}
+
+func (p PluginWithdrawalData) HashString() string {
+	if p.ProposalData == nil {
+		return ""
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(p.ProposalData)
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func (p PluginWithdrawalData) SigData() [][]byte {
+	if p.ProposalData == nil {
+		return nil
+	}
+
+	return p.ProposalData.SigHashes
+}
+
+// SigningData returns the signing data in the adapter representation.
+func (p PluginWithdrawalData) SigningData() *adapterTypes.SigningData {
+	return &adapterTypes.SigningData{
+		DepositIds: p.ProposalData.DepositIds,
+		Data:       p.ProposalData.Data,
+		SigHashes:  p.ProposalData.SigHashes,
+	}
+}
+
+type PluginWithdrawalConstructor struct {
+	client       *plugin.Client
+	maxSigHashes int
+}
+
+// NewPluginConstructor creates the constructor delegating to the chain adapter,
+// maxSigHashes bounds the number of hashes signed within one session.
+func NewPluginConstructor(client *plugin.Client, maxSigHashes int) *PluginWithdrawalConstructor {
+	return &PluginWithdrawalConstructor{
+		client:       client,
+		maxSigHashes: maxSigHashes,
+	}
+}
+
+func (c *PluginWithdrawalConstructor) FormSigningData(deposits []db.Deposit) (*PluginWithdrawalData, error) {
+	data, err := c.client.FormSigningData(deposits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to form plugin withdrawal")
+	}
+	// the adapter has nothing to withdraw at the moment
+	if len(data.GetSigHashes()) == 0 {
+		return nil, nil
+	}
+
+	withdrawalData := PluginWithdrawalData{
+		ProposalData: &p2p.PluginProposalData{
+			DepositIds: data.DepositIds,
+			Data:       data.Data,
+			SigHashes:  data.SigHashes,
+		},
+	}
+	if err = c.validateShape(withdrawalData, deposits); err != nil {
+		return nil, errors.Wrap(err, "invalid adapter signing data")
+	}
+
+	return &withdrawalData, nil
+}
+
+func (c *PluginWithdrawalConstructor) IsValid(data PluginWithdrawalData, deposits []db.Deposit) (bool, error) {
+	if data.ProposalData == nil {
+		return false, errors.New("invalid proposal data")
+	}
+	if err := c.validateShape(data, deposits); err != nil {
+		return false, errors.Wrap(err, "invalid proposal data")
+	}
+
+	return c.client.ValidateSigningData(data.SigningData(), deposits)
+}
+
+// validateShape checks the signing data includes only the provided deposits
+// and can be signed within one session.
+func (c *PluginWithdrawalConstructor) validateShape(data PluginWithdrawalData, deposits []db.Deposit) error {
+	hashesCount := len(data.ProposalData.SigHashes)
+	if hashesCount == 0 || hashesCount > c.maxSigHashes {
+		return errors.New(fmt.Sprintf("sig hashes count %d is out of range [1, %d]", hashesCount, c.maxSigHashes))
+	}
+
+	provided := make(map[db.DepositIdentifier]struct{}, len(deposits))
+	for _, deposit := range deposits {
+		provided[deposit.DepositIdentifier] = struct{}{}
+	}
+
+	identifiers := data.DepositIdentifiers()
+	if len(identifiers) == 0 {
+		return errors.New("no deposits included")
+	}
+	for _, identifier := range identifiers {
+		if _, ok := provided[identifier]; !ok {
+			return errors.New(fmt.Sprintf("unexpected deposit %s included", identifier))
+		}
+	}
+
+	return nil
+}
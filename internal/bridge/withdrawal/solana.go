@@ -1,14 +1,12 @@
 package withdrawal
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"fmt"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/db"
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
-	"github.com/Bridgeless-Project/tss-svc/internal/types"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 )
@@ -21,18 +19,12 @@ type SolanaWithdrawalData struct {
 	SignedWithdrawal string
 }
 
-func (e SolanaWithdrawalData) DepositIdentifier() db.DepositIdentifier {
-	identifier := db.DepositIdentifier{}
-
-	if e.ProposalData == nil || e.ProposalData.DepositId == nil {
-		return identifier
+func (e SolanaWithdrawalData) DepositIdentifiers() []db.DepositIdentifier {
+	if e.ProposalData == nil {
+		return nil
 	}
 
-	identifier.ChainId = e.ProposalData.DepositId.ChainId
-	identifier.TxHash = e.ProposalData.DepositId.TxHash
-	identifier.TxNonce = e.ProposalData.DepositId.TxNonce
-
-	return identifier
+	return depositIdentifiers(e.ProposalData.Deposits)
 }
 
 func (e SolanaWithdrawalData) HashString() string {
@@ -48,6 +40,14 @@ func (e SolanaWithdrawalData) HashString() string {
 	return fmt.Sprintf("%x", sha256.Sum256(data))
 }
 
+func (e SolanaWithdrawalData) SigData() [][]byte {
+	if e.ProposalData == nil {
+		return nil
+	}
+
+	return depositsSigData(e.ProposalData.Deposits)
+}
+
 func NewSolanaConstructor(client *solana.Client) *SolanaWithdrawalConstructor {
 	return &SolanaWithdrawalConstructor{
 		client: client,
@@ -58,37 +58,21 @@ type SolanaWithdrawalConstructor struct {
 	client *solana.Client
 }
 
-func (c *SolanaWithdrawalConstructor) FormSigningData(deposit db.Deposit) (*SolanaWithdrawalData, error) {
-	sigHash, err := c.client.GetSignHash(deposit)
+func (c *SolanaWithdrawalConstructor) FormSigningData(deposits []db.Deposit) (*SolanaWithdrawalData, error) {
+	sigData, err := formDepositsSigData(deposits, c.client.GetSignHash)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get signing hash")
+		return nil, errors.Wrap(err, "failed to form deposits signing data")
 	}
 
 	return &SolanaWithdrawalData{
-		ProposalData: &p2p.SolanaProposalData{
-			DepositId: &types.DepositIdentifier{
-				ChainId: deposit.ChainId,
-				TxHash:  deposit.TxHash,
-				TxNonce: deposit.TxNonce,
-			},
-			SigData: sigHash,
-		},
+		ProposalData: &p2p.SolanaProposalData{Deposits: sigData},
 	}, nil
 }
 
-func (c *SolanaWithdrawalConstructor) IsValid(data SolanaWithdrawalData, deposit db.Deposit) (bool, error) {
+func (c *SolanaWithdrawalConstructor) IsValid(data SolanaWithdrawalData, deposits []db.Deposit) (bool, error) {
 	if data.ProposalData == nil {
 		return false, errors.New("invalid proposal data")
 	}
 
-	sigHash, err := c.client.GetSignHash(deposit)
-	if err != nil {
-		return false, errors.Wrap(err, "failed to get signing hash")
-	}
-
-	if !bytes.Equal(data.ProposalData.SigData, sigHash) {
-		return false, errors.New("sig data does not match the expected one")
-	}
-
-	return true, nil
+	return validateDepositsSigData(data.ProposalData.Deposits, deposits, c.client.GetSignHash)
 }
@@ -29,18 +29,22 @@ type UtxoWithdrawalData struct {
 	ProposalData *p2p.BitcoinProposalData
 }
 
-func (e UtxoWithdrawalData) DepositIdentifier() db.DepositIdentifier {
-	identifier := db.DepositIdentifier{}
+func (e UtxoWithdrawalData) DepositIdentifiers() []db.DepositIdentifier {
+	if e.ProposalData == nil {
+		return nil
+	}
 
-	if e.ProposalData == nil || e.ProposalData.DepositId == nil {
-		return identifier
+	identifiers := make([]db.DepositIdentifier, len(e.ProposalData.DepositIds))
+	for i, id := range e.ProposalData.DepositIds {
+		identifiers[i] = toDepositIdentifier(id)
 	}
 
-	identifier.ChainId = e.ProposalData.DepositId.ChainId
-	identifier.TxHash = e.ProposalData.DepositId.TxHash
-	identifier.TxNonce = e.ProposalData.DepositId.TxNonce
+	return identifiers
+}
 
-	return identifier
+// IsReplacement reports whether the data replaces the stuck withdrawal transaction.
+func (e UtxoWithdrawalData) IsReplacement() bool {
+	return e.ProposalData != nil && e.ProposalData.ReplacedTxHash != ""
 }
 
 func (e UtxoWithdrawalData) HashString() string {
@@ -67,20 +71,15 @@ func NewUtxoConstructor(client client.Client, tssPub *ecdsa.PublicKey) *UtxoWith
 	return &UtxoWithdrawalConstructor{
 		client:  client,
 		helper:  hlp,
-		tssAddr: hlp.P2pkhAddress(tssPub),
+		tssAddr: hlp.WalletAddress(tssPub),
 	}
 }
 
-func (c *UtxoWithdrawalConstructor) FormSigningData(deposit db.Deposit) (*UtxoWithdrawalData, error) {
-	amount, set := new(big.Int).SetString(deposit.WithdrawalAmount, 10)
-	if !set {
-		return nil, errors.New("failed to parse amount")
-	}
-	receiverScript, err := c.helper.PayToAddrScript(deposit.Receiver)
+func (c *UtxoWithdrawalConstructor) FormSigningData(deposits []db.Deposit) (*UtxoWithdrawalData, error) {
+	receiverOutputs, err := c.receiverOutputs(deposits)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create script")
+		return nil, errors.Wrap(err, "failed to form receiver outputs")
 	}
-	receiverOutput := wire.NewTxOut(amount.Int64(), receiverScript)
 
 	unspent, err := c.client.ListUnspent()
 	if err != nil {
@@ -91,7 +90,7 @@ func (c *UtxoWithdrawalConstructor) FormSigningData(deposit db.Deposit) (*UtxoWi
 	unsignedTxData, err := c.helper.NewUnsignedTransaction(
 		unspent,
 		feeRate,
-		[]*wire.TxOut{receiverOutput},
+		receiverOutputs,
 		c.tssAddr,
 	)
 	if err != nil {
@@ -112,28 +111,37 @@ func (c *UtxoWithdrawalConstructor) FormSigningData(deposit db.Deposit) (*UtxoWi
 	}
 	txSerialized := buf.Bytes()
 
+	depositIds := make([]*types.DepositIdentifier, len(deposits))
+	for i, deposit := range deposits {
+		depositIds[i] = deposit.ToMsgDepositIdentifier()
+	}
+
 	return &UtxoWithdrawalData{
 		ProposalData: &p2p.BitcoinProposalData{
-			DepositId: &types.DepositIdentifier{
-				ChainId: deposit.ChainId,
-				TxNonce: deposit.TxNonce,
-				TxHash:  deposit.TxHash,
-			},
+			DepositIds:   depositIds,
 			SerializedTx: txSerialized,
 			FeeRate:      int64(feeRate),
 			SigData:      sigHashes,
+			PrevScripts:  unsignedTxData.PrevScripts,
 		},
 	}, nil
 }
 
-func (c *UtxoWithdrawalConstructor) IsValid(data UtxoWithdrawalData, deposit db.Deposit) (bool, error) {
+func (c *UtxoWithdrawalConstructor) IsValid(data UtxoWithdrawalData, deposits []db.Deposit) (bool, error) {
+	if data.ProposalData == nil {
+		return false, errors.New("invalid proposal data")
+	}
+	if data.IsReplacement() {
+		return false, errors.New("replacement data provided as a regular withdrawal")
+	}
+
 	tx := wire.MsgTx{}
 	if err := tx.Deserialize(bytes.NewReader(data.ProposalData.SerializedTx)); err != nil {
 		return false, errors.Wrap(err, "failed to deserialize transaction")
 	}
 
 	feeRate := btcutil.Amount(data.ProposalData.FeeRate)
-	if !utils.FeeRateValid(feeRate) {
+	if !helper.FeeRateValid(c.helper, feeRate) {
 		return false, errors.Errorf("invalid fee rate: %d", data.ProposalData.FeeRate)
 	}
 
@@ -147,20 +155,15 @@ func (c *UtxoWithdrawalConstructor) IsValid(data UtxoWithdrawalData, deposit db.
 		return false, errors.Wrap(err, "failed to find tx used inputs")
 	}
 
-	amount, set := new(big.Int).SetString(deposit.WithdrawalAmount, 10)
-	if !set {
-		return false, errors.New("failed to parse amount")
-	}
-	receiverScript, err := c.helper.PayToAddrScript(deposit.Receiver)
+	receiverOutputs, err := c.receiverOutputs(deposits)
 	if err != nil {
-		return false, errors.Wrap(err, "failed to create script")
+		return false, errors.Wrap(err, "failed to form receiver outputs")
 	}
-	receiverOutput := wire.NewTxOut(amount.Int64(), receiverScript)
 
 	unsignedTxData, err := c.helper.NewUnsignedTransaction(
 		usedInputs,
 		feeRate,
-		[]*wire.TxOut{receiverOutput},
+		receiverOutputs,
 		c.tssAddr,
 	)
 	if err != nil {
@@ -190,10 +193,108 @@ func (c *UtxoWithdrawalConstructor) IsValid(data UtxoWithdrawalData, deposit db.
 			return false, errors.Errorf("signature hash mismatch at index %d", i)
 		}
 	}
+	if len(unsignedTxData.PrevScripts) != len(data.ProposalData.PrevScripts) {
+		return false, errors.New("previous scripts number mismatch")
+	}
+	for i, script := range data.ProposalData.PrevScripts {
+		if !bytes.Equal(script, unsignedTxData.PrevScripts[i]) {
+			return false, errors.Errorf("previous script mismatch at index %d", i)
+		}
+	}
 
 	return true, nil
 }
 
+// FormReplacementData forms the signing data of the transaction replacing the stuck one.
+// The replacement spends the same inputs and pays the same receivers at the provided fee rate.
+// Previous outputs must be provided for each input of the stuck transaction.
+func (c *UtxoWithdrawalConstructor) FormReplacementData(
+	stuckTxHash string,
+	stuckTx *wire.MsgTx,
+	prevOuts []*wire.TxOut,
+	deposits []db.Deposit,
+	feeRate btcutil.Amount,
+) (*UtxoWithdrawalData, error) {
+	if len(prevOuts) != len(stuckTx.TxIn) {
+		return nil, errors.New("previous outputs number mismatch")
+	}
+
+	receiverOutputs, err := c.receiverOutputs(deposits)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to form receiver outputs")
+	}
+
+	tx := wire.NewMsgTx(stuckTx.Version)
+	tx.LockTime = stuckTx.LockTime
+	unsignedTxData := &txauthor.AuthoredTx{
+		Tx:              tx,
+		PrevScripts:     make([][]byte, len(prevOuts)),
+		PrevInputValues: make([]btcutil.Amount, len(prevOuts)),
+		ChangeIndex:     -1,
+	}
+	for i, in := range stuckTx.TxIn {
+		txIn := wire.NewTxIn(&in.PreviousOutPoint, nil, nil)
+		txIn.Sequence = utils.ReplaceableSequenceNum
+		tx.AddTxIn(txIn)
+
+		unsignedTxData.PrevScripts[i] = prevOuts[i].PkScript
+		unsignedTxData.PrevInputValues[i] = btcutil.Amount(prevOuts[i].Value)
+		unsignedTxData.TotalInput += btcutil.Amount(prevOuts[i].Value)
+	}
+
+	withdrawn := int64(0)
+	for _, out := range receiverOutputs {
+		tx.AddTxOut(out)
+		withdrawn += out.Value
+	}
+	if withdrawn > int64(unsignedTxData.TotalInput) {
+		return nil, errors.New("withdrawal amount exceeds the replaced transaction inputs")
+	}
+
+	// keeping the change at the TSS wallet if possible, as the regular withdrawal does
+	changeScript, err := c.helper.PayToAddrScript(c.tssAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create change script")
+	}
+	txWithChange := tx.Copy()
+	txWithChange.AddTxOut(wire.NewTxOut(0, changeScript))
+	change := int64(unsignedTxData.TotalInput) - withdrawn -
+		int64(c.helper.EstimateFee(txWithChange, unsignedTxData.PrevScripts, feeRate))
+	if c.client.WithdrawalAmountValid(big.NewInt(change)) {
+		txWithChange.TxOut[len(receiverOutputs)].Value = change
+		unsignedTxData.Tx = txWithChange
+		unsignedTxData.ChangeIndex = len(receiverOutputs)
+	}
+
+	unsignedTxData = c.subtractFeeFromWithdrawal(unsignedTxData, feeRate)
+
+	sigHashes, err := c.formSignatureHashes(unsignedTxData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to form signature hashes")
+	}
+
+	var buf bytes.Buffer
+	if err = unsignedTxData.Tx.Serialize(&buf); err != nil {
+		return nil, errors.Wrap(err, "failed to serialize transaction")
+	}
+
+	depositIds := make([]*types.DepositIdentifier, len(deposits))
+	for i, deposit := range deposits {
+		depositIds[i] = deposit.ToMsgDepositIdentifier()
+	}
+
+	return &UtxoWithdrawalData{
+		ProposalData: &p2p.BitcoinProposalData{
+			DepositIds:     depositIds,
+			SerializedTx:   buf.Bytes(),
+			FeeRate:        int64(feeRate),
+			SigData:        sigHashes,
+			PrevScripts:    unsignedTxData.PrevScripts,
+			ReplacedTxHash: stuckTxHash,
+		},
+	}, nil
+}
+
 func (c *UtxoWithdrawalConstructor) formSignatureHashes(unsignedTxData *txauthor.AuthoredTx) ([][]byte, error) {
 	sigHashes := make([][]byte, len(unsignedTxData.Tx.TxIn))
 	for i := range unsignedTxData.PrevScripts {
@@ -213,19 +314,51 @@ func (c *UtxoWithdrawalConstructor) formSignatureHashes(unsignedTxData *txauthor
 	return sigHashes, nil
 }
 
+func (c *UtxoWithdrawalConstructor) receiverOutputs(deposits []db.Deposit) ([]*wire.TxOut, error) {
+	if len(deposits) == 0 {
+		return nil, errors.New("no deposits provided")
+	}
+
+	outputs := make([]*wire.TxOut, len(deposits))
+	for i, deposit := range deposits {
+		amount, set := new(big.Int).SetString(deposit.WithdrawalAmount, 10)
+		if !set {
+			return nil, errors.Errorf("failed to parse amount for deposit %s", deposit.DepositIdentifier)
+		}
+		receiverScript, err := c.helper.PayToAddrScript(deposit.Receiver)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create script for deposit %s", deposit.DepositIdentifier)
+		}
+
+		outputs[i] = wire.NewTxOut(amount.Int64(), receiverScript)
+	}
+
+	return outputs, nil
+}
+
+// subtractFeeFromWithdrawal charges the transaction fee from the receiver outputs.
+// Receiver outputs are expected to precede the change output (if any).
+// The fee is split equally between the receivers, the indivisible remainder
+// is charged from the first receivers one unit each.
 func (c *UtxoWithdrawalConstructor) subtractFeeFromWithdrawal(tx *txauthor.AuthoredTx, feeRate btcutil.Amount) *txauthor.AuthoredTx {
-	fee := c.helper.EstimateFee(tx.Tx, feeRate)
-	withdrawalAmount := tx.Tx.TxOut[0].Value - int64(fee)
+	receivers := len(tx.Tx.TxOut)
+	if tx.ChangeIndex != -1 {
+		receivers--
+	}
+	if receivers <= 0 {
+		return tx
+	}
 
-	if !c.client.WithdrawalAmountValid(big.NewInt(withdrawalAmount)) {
-		// cannot subtract fee from withdrawalAmount, it would result in an invalid amount
+	fee := c.helper.EstimateFee(tx.Tx, tx.PrevScripts, feeRate)
+	if !c.feeSharesValid(tx.Tx, receivers, fee) {
+		// cannot subtract fee from some withdrawal amount, it would result in an invalid amount
 		// commission will be paid by the TSS service
 		// should not happen if the bridging is configured correctly
 		return tx
 	}
 
 	if tx.ChangeIndex != -1 {
-		tx.Tx.TxOut[0].Value -= int64(fee)
+		chargeFee(tx.Tx, receivers, fee)
 		tx.Tx.TxOut[tx.ChangeIndex].Value += int64(fee)
 
 		return tx
@@ -236,27 +369,59 @@ func (c *UtxoWithdrawalConstructor) subtractFeeFromWithdrawal(tx *txauthor.Autho
 	changeScript, _ := c.helper.PayToAddrScript(c.tssAddr)
 	txWithChange.AddTxOut(wire.NewTxOut(0, changeScript))
 
-	feeWithChange := c.helper.EstimateFee(txWithChange, feeRate)
-	withdrawalAmountWithChange := tx.Tx.TxOut[0].Value - int64(feeWithChange)
-	if !c.client.WithdrawalAmountValid(big.NewInt(withdrawalAmountWithChange)) {
+	feeWithChange := c.helper.EstimateFee(txWithChange, tx.PrevScripts, feeRate)
+	if !c.feeSharesValid(txWithChange, receivers, feeWithChange) {
 		// commission still will be paid by the TSS service
 		// should not happen if the bridging is configured correctly
 		return tx
 	}
 
-	change := int64(tx.TotalInput) - withdrawalAmountWithChange - int64(feeWithChange)
+	withdrawn := int64(0)
+	for _, out := range tx.Tx.TxOut[:receivers] {
+		withdrawn += out.Value
+	}
+	change := int64(tx.TotalInput) - withdrawn
 
 	if !c.client.WithdrawalAmountValid(big.NewInt(change)) {
-		// cannot add change output, just pay the old fee from the withdrawalAmount
-		tx.Tx.TxOut[0].Value -= int64(fee)
+		// cannot add change output, just pay the old fee from the withdrawal amounts
+		chargeFee(tx.Tx, receivers, fee)
 
 		return tx
 	}
 
-	txWithChange.TxOut[0].Value -= int64(feeWithChange)
-	txWithChange.TxOut[1].Value = change
+	chargeFee(txWithChange, receivers, feeWithChange)
+	txWithChange.TxOut[receivers].Value = change
 	tx.Tx = txWithChange
-	tx.ChangeIndex = 1
+	tx.ChangeIndex = receivers
 
 	return tx
 }
+
+func (c *UtxoWithdrawalConstructor) feeSharesValid(tx *wire.MsgTx, receivers int, fee btcutil.Amount) bool {
+	for i, share := range feeShares(fee, receivers) {
+		if !c.client.WithdrawalAmountValid(big.NewInt(tx.TxOut[i].Value - share)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func chargeFee(tx *wire.MsgTx, receivers int, fee btcutil.Amount) {
+	for i, share := range feeShares(fee, receivers) {
+		tx.TxOut[i].Value -= share
+	}
+}
+
+func feeShares(fee btcutil.Amount, receivers int) []int64 {
+	shares := make([]int64, receivers)
+	base, remainder := int64(fee)/int64(receivers), int64(fee)%int64(receivers)
+	for i := range shares {
+		shares[i] = base
+		if int64(i) < remainder {
+			shares[i]++
+		}
+	}
+
+	return shares
+}
@@ -1,6 +1,7 @@
 package withdrawal
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"math/big"
 	"os"
@@ -9,6 +10,8 @@ import (
 	utxochain "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/chain"
 	utxo "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
 	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -163,3 +166,82 @@ func Test_SubtractFee(t *testing.T) {
 		})
 	}
 }
+
+func Test_SubtractFee_MultipleReceivers(t *testing.T) {
+	tx := baseTx() // 1 inp 3 outs
+	tx.AddTxOut(&wire.TxOut{Value: 5000, PkScript: receiverScript})
+	tx.AddTxOut(&wire.TxOut{Value: 6000, PkScript: receiverScript})
+	tx.AddTxOut(&wire.TxOut{ // change output
+		Value:    3000,
+		PkScript: receiverScript,
+	})
+
+	finalTx := constructor.subtractFeeFromWithdrawal(&txauthor.AuthoredTx{
+		Tx:          tx,
+		TotalInput:  14261,
+		ChangeIndex: 2,
+	}, 1000)
+
+	fee := int64(constructor.helper.EstimateFee(tx, nil, 1000))
+	expected := []int64{5000 - fee/2 - fee%2, 6000 - fee/2, 3000 + fee}
+	for i, value := range expected {
+		if finalTx.Tx.TxOut[i].Value != value {
+			t.Fatalf("expected output %d value to be %d, got %d", i, value, finalTx.Tx.TxOut[i].Value)
+		}
+	}
+}
+
+func Test_FeeShares(t *testing.T) {
+	shares := feeShares(10, 3)
+	expected := []int64{4, 3, 3}
+	for i := range expected {
+		if shares[i] != expected[i] {
+			t.Fatalf("expected share %d to be %d, got %d", i, expected[i], shares[i])
+		}
+	}
+}
+
+func Test_FormReplacementData(t *testing.T) {
+	stuckTx := baseTx()
+	stuckTx.AddTxOut(&wire.TxOut{Value: 4773, PkScript: receiverScript})
+	stuckTx.AddTxOut(&wire.TxOut{Value: 15000, PkScript: receiverScript})
+
+	deposits := []db.Deposit{{
+		Receiver:         utxoclient.UtxoHelper().P2pkhAddress(pk),
+		WithdrawalAmount: "5000",
+	}}
+	prevOuts := []*wire.TxOut{{Value: 20000, PkScript: receiverScript}}
+
+	data, err := constructor.FormReplacementData("0xstuck", stuckTx, prevOuts, deposits, 3000)
+	if err != nil {
+		t.Fatalf("failed to form replacement data: %v", err)
+	}
+	if !data.IsReplacement() {
+		t.Fatal("expected replacement data")
+	}
+
+	tx := &wire.MsgTx{}
+	if err = tx.Deserialize(bytes.NewReader(data.ProposalData.SerializedTx)); err != nil {
+		t.Fatalf("failed to deserialize replacement: %v", err)
+	}
+	if len(tx.TxIn) != 1 || tx.TxIn[0].PreviousOutPoint != stuckTx.TxIn[0].PreviousOutPoint {
+		t.Fatal("expected replacement to spend the same inputs")
+	}
+	if tx.TxIn[0].Sequence != utils.ReplaceableSequenceNum {
+		t.Fatalf("expected replaceable sequence, got %d", tx.TxIn[0].Sequence)
+	}
+	if len(tx.TxOut) != 2 {
+		t.Fatalf("expected 2 outputs, got %d", len(tx.TxOut))
+	}
+
+	fee := int64(constructor.helper.EstimateFee(tx, data.ProposalData.PrevScripts, 3000))
+	if tx.TxOut[0].Value != 5000-fee {
+		t.Fatalf("expected withdrawal %d, got %d", 5000-fee, tx.TxOut[0].Value)
+	}
+	if tx.TxOut[1].Value != 15000 {
+		t.Fatalf("expected change 15000, got %d", tx.TxOut[1].Value)
+	}
+	if len(data.ProposalData.SigData) != 1 {
+		t.Fatalf("expected 1 signature hash, got %d", len(data.ProposalData.SigData))
+	}
+}
@@ -25,18 +25,12 @@ type ZanoWithdrawalData struct {
 	ProposalData *p2p.ZanoProposalData
 }
 
-func (z ZanoWithdrawalData) DepositIdentifier() db.DepositIdentifier {
-	identifier := db.DepositIdentifier{}
-
+func (z ZanoWithdrawalData) DepositIdentifiers() []db.DepositIdentifier {
 	if z.ProposalData == nil || z.ProposalData.DepositId == nil {
-		return identifier
+		return nil
 	}
 
-	identifier.ChainId = z.ProposalData.DepositId.ChainId
-	identifier.TxHash = z.ProposalData.DepositId.TxHash
-	identifier.TxNonce = z.ProposalData.DepositId.TxNonce
-
-	return identifier
+	return []db.DepositIdentifier{toDepositIdentifier(z.ProposalData.DepositId)}
 }
 
 func (z ZanoWithdrawalData) HashString() string {
@@ -52,6 +46,15 @@ func (z ZanoWithdrawalData) HashString() string {
 	return fmt.Sprintf("%x", sha256.Sum256(data))
 }
 
+// SigData returns the transaction hash signed within the session, withdrawals are not batched.
+func (z ZanoWithdrawalData) SigData() [][]byte {
+	if z.ProposalData == nil {
+		return nil
+	}
+
+	return [][]byte{z.ProposalData.SigData}
+}
+
 type ZanoWithdrawalConstructor struct {
 	client *zano.Client
 }
@@ -62,7 +65,12 @@ func NewZanoConstructor(client *zano.Client) *ZanoWithdrawalConstructor {
 	}
 }
 
-func (c *ZanoWithdrawalConstructor) FormSigningData(deposit db.Deposit) (*ZanoWithdrawalData, error) {
+func (c *ZanoWithdrawalConstructor) FormSigningData(deposits []db.Deposit) (*ZanoWithdrawalData, error) {
+	deposit, err := singleDeposit(deposits)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid deposits to form signing data")
+	}
+
 	tx, err := c.client.EmitAssetUnsigned(deposit)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to form zano withdrawal data")
@@ -85,7 +93,12 @@ func (c *ZanoWithdrawalConstructor) FormSigningData(deposit db.Deposit) (*ZanoWi
 	}, nil
 }
 
-func (c *ZanoWithdrawalConstructor) IsValid(data ZanoWithdrawalData, deposit db.Deposit) (bool, error) {
+func (c *ZanoWithdrawalConstructor) IsValid(data ZanoWithdrawalData, deposits []db.Deposit) (bool, error) {
+	deposit, err := singleDeposit(deposits)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid deposits to validate signing data")
+	}
+
 	details, err := c.client.DecryptTxDetails(zanoTypes.DataForExternalSigning{
 		OutputsAddresses: data.ProposalData.OutputsAddresses,
 		UnsignedTx:       data.ProposalData.UnsignedTx,
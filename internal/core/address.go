@@ -43,15 +43,40 @@ func (a Address) Bytes() []byte {
 }
 
 func (a Address) PartyIdentifier() *tss.PartyID {
+	return a.PartyIdentifierAt(0)
+}
+
+func (a Address) PartyKey() *big.Int {
+	return a.PartyKeyAt(0)
+}
+
+// partyKeyGenerationShift places the key generation above the address bytes,
+// so the party keys of different generations never collide and keep the address order.
+const partyKeyGenerationShift = 256
+
+// PartyIdentifierAt returns the party identifier for the key shares of the given generation.
+func (a Address) PartyIdentifierAt(generation uint64) *tss.PartyID {
 	return tss.NewPartyID(
 		a.String(),
 		a.String(),
-		a.PartyKey(),
+		a.PartyKeyAt(generation),
 	)
 }
 
-func (a Address) PartyKey() *big.Int {
-	return new(big.Int).SetBytes(a.Bytes())
+// PartyKeyAt returns the party key for the key shares of the given generation.
+// The keygen produces the shares of the zero generation, and every key resharing
+// moves the shares to the next one, letting the party be a member of both
+// the old and the new committees.
+func (a Address) PartyKeyAt(generation uint64) *big.Int {
+	key := new(big.Int).SetUint64(generation)
+	key.Lsh(key, partyKeyGenerationShift)
+
+	return key.Add(key, new(big.Int).SetBytes(a.Bytes()))
+}
+
+// KeyGeneration returns the generation of the key shares the party key belongs to.
+func KeyGeneration(partyKey *big.Int) uint64 {
+	return new(big.Int).Rsh(partyKey, partyKeyGenerationShift).Uint64()
 }
 
 func AddrFromPartyId(id *tss.PartyID) Address {
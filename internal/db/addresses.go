@@ -0,0 +1,23 @@
+package db
+
+type DepositAddressesQ interface {
+	New() DepositAddressesQ
+	// Insert stores the deposit address, the already existing address is left unchanged
+	Insert(address DepositAddress) error
+	Get(chainId, address string) (*DepositAddress, error)
+	// Select returns the known deposit addresses of the chain among the given ones
+	Select(chainId string, addresses []string) ([]DepositAddress, error)
+	GetNotDistributed() (*DepositAddress, error)
+	UpdateDistributedStatus(chainId, address string, distributed bool) error
+}
+
+// DepositAddress is the address derived from the TSS public key
+// to receive the deposits of the receiver on the destination chain without the memo.
+type DepositAddress struct {
+	ChainId            string `structs:"chain_id" db:"chain_id"`
+	Address            string `structs:"address" db:"address"`
+	DestinationChainId string `structs:"destination_chain_id" db:"destination_chain_id"`
+	DestinationAddress string `structs:"destination_address" db:"destination_address"`
+
+	Distributed bool `structs:"distributed" db:"distributed"`
+}
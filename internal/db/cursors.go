@@ -0,0 +1,13 @@
+package db
+
+type BlockCursorsQ interface {
+	New() BlockCursorsQ
+	Get(chainId string) (*BlockCursor, error)
+	Upsert(cursor BlockCursor) error
+}
+
+// BlockCursor stores the last block that was fully processed for the chain.
+type BlockCursor struct {
+	ChainId string `structs:"chain_id" db:"chain_id"`
+	Block   int64  `structs:"block" db:"block"`
+}
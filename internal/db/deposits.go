@@ -3,6 +3,7 @@ package db
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	bridgetypes "github.com/Bridgeless-Project/bridgeless-core/v12/x/bridge/types"
 	"github.com/Bridgeless-Project/tss-svc/internal/types"
@@ -34,6 +35,11 @@ type DepositsQ interface {
 	UpdateProcessed(data ProcessedDepositData) error
 	UpdateSubmittedStatus(identifier DepositIdentifier, submitted bool) error
 	UpdateDistributedStatus(identifier DepositIdentifier, distributed bool) error
+	UpdatePendingConfirmation(identifier DepositIdentifier, pending bool) error
+	UpdateWithdrawalTxNonce(identifier DepositIdentifier, nonce int64) error
+	// UpdateWithdrawalTxHash sets the hash of the withdrawal transaction sent after the deposit was processed,
+	// the deposit is resubmitted to core with the hash
+	UpdateWithdrawalTxHash(identifier DepositIdentifier, hash string) error
 
 	Transaction(f func() error) error
 }
@@ -61,11 +67,16 @@ type DepositsSelector struct {
 	ChainId           *string
 	WithdrawalChainId *string
 	One               bool
-	Status            *types.WithdrawalStatus
-	NotSubmitted      bool
+	// Limit restricts the number of selected deposits ordered by insertion, ignored if not positive
+	Limit        uint64
+	Status       *types.WithdrawalStatus
+	NotSubmitted bool
 
 	Distributed    bool
 	NotDistributed bool
+
+	WithdrawalTxHash    *string
+	PendingConfirmation bool
 }
 
 func (d DepositIdentifier) String() string {
@@ -98,6 +109,9 @@ type Deposit struct {
 	WithdrawalTxHash  *string `structs:"withdrawal_tx_hash" db:"withdrawal_tx_hash"`
 	WithdrawalChainId string  `structs:"withdrawal_chain_id" db:"withdrawal_chain_id"`
 	WithdrawalAmount  string  `structs:"withdrawal_amount" db:"withdrawal_amount"`
+	// WithdrawalTxNonce identifies the withdrawal transaction together with its hash, f.e. the TON transaction lt,
+	// it is not submitted to core
+	WithdrawalTxNonce *int64 `structs:"withdrawal_tx_nonce" db:"withdrawal_tx_nonce"`
 
 	IsWrappedToken bool `structs:"is_wrapped_token" db:"is_wrapped_token"`
 
@@ -106,6 +120,11 @@ type Deposit struct {
 
 	Submitted   bool `structs:"submitted" db:"submitted"`
 	Distributed bool `structs:"distributed" db:"distributed"`
+
+	PendingConfirmation bool `structs:"pending_confirmation" db:"pending_confirmation"`
+	// PendingSince is the time the pending withdrawal transaction was saved at,
+	// all parties save it during the same finalization phase
+	PendingSince *time.Time `structs:"pending_since" db:"pending_since"`
 }
 
 func (d Deposit) ToTransaction() bridgetypes.Transaction {
@@ -176,6 +195,11 @@ type ProcessedDepositData struct {
 	Signature *string
 	TxHash    *string
 	TxData    *string
+
+	// PendingConfirmation marks the withdrawal transaction as broadcast but not yet confirmed
+	PendingConfirmation bool
+	// Resubmit resets the submitted status, so the replaced withdrawal hash is resubmitted to core
+	Resubmit bool
 }
 
 func stringOrEmpty(s *string) string {
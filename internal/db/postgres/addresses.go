@@ -0,0 +1,95 @@
+package pg
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/kit/pgdb"
+)
+
+const (
+	depositAddressesTable              = "deposit_addresses"
+	depositAddressesChainId            = "chain_id"
+	depositAddressesAddress            = "address"
+	depositAddressesDestinationChainId = "destination_chain_id"
+	depositAddressesDestinationAddress = "destination_address"
+	depositAddressesDistributed        = "distributed"
+)
+
+type depositAddressesQ struct {
+	db       *pgdb.DB
+	selector squirrel.SelectBuilder
+}
+
+func NewDepositAddressesQ(db *pgdb.DB) db.DepositAddressesQ {
+	return &depositAddressesQ{
+		db:       db.Clone(),
+		selector: squirrel.Select("*").From(depositAddressesTable),
+	}
+}
+
+func (q *depositAddressesQ) New() db.DepositAddressesQ {
+	return NewDepositAddressesQ(q.db.Clone())
+}
+
+func (q *depositAddressesQ) Insert(address db.DepositAddress) error {
+	stmt := squirrel.
+		Insert(depositAddressesTable).
+		SetMap(map[string]interface{}{
+			depositAddressesChainId:            address.ChainId,
+			depositAddressesAddress:            address.Address,
+			depositAddressesDestinationChainId: address.DestinationChainId,
+			depositAddressesDestinationAddress: address.DestinationAddress,
+			depositAddressesDistributed:        address.Distributed,
+		}).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, %s) DO NOTHING", depositAddressesChainId, depositAddressesAddress))
+
+	return q.db.Exec(stmt)
+}
+
+func (q *depositAddressesQ) Get(chainId, address string) (*db.DepositAddress, error) {
+	var result db.DepositAddress
+	err := q.db.Get(&result, q.selector.Where(squirrel.Eq{
+		depositAddressesChainId: chainId,
+		depositAddressesAddress: address,
+	}))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return &result, err
+}
+
+func (q *depositAddressesQ) Select(chainId string, addresses []string) ([]db.DepositAddress, error) {
+	var result []db.DepositAddress
+	err := q.db.Select(&result, q.selector.Where(squirrel.Eq{
+		depositAddressesChainId: chainId,
+		depositAddressesAddress: addresses,
+	}))
+
+	return result, err
+}
+
+func (q *depositAddressesQ) GetNotDistributed() (*db.DepositAddress, error) {
+	var result db.DepositAddress
+	err := q.db.Get(&result, q.selector.Where(squirrel.Eq{depositAddressesDistributed: false}).Limit(1))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return &result, err
+}
+
+func (q *depositAddressesQ) UpdateDistributedStatus(chainId, address string, distributed bool) error {
+	stmt := squirrel.Update(depositAddressesTable).
+		Set(depositAddressesDistributed, distributed).
+		Where(squirrel.Eq{
+			depositAddressesChainId: chainId,
+			depositAddressesAddress: address,
+		})
+
+	return q.db.Exec(stmt)
+}
@@ -0,0 +1,55 @@
+package pg
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/kit/pgdb"
+)
+
+const (
+	cursorsTable   = "block_cursors"
+	cursorsChainId = "chain_id"
+	cursorsBlock   = "block"
+)
+
+type blockCursorsQ struct {
+	db       *pgdb.DB
+	selector squirrel.SelectBuilder
+}
+
+func NewBlockCursorsQ(db *pgdb.DB) db.BlockCursorsQ {
+	return &blockCursorsQ{
+		db:       db.Clone(),
+		selector: squirrel.Select("*").From(cursorsTable),
+	}
+}
+
+func (c *blockCursorsQ) New() db.BlockCursorsQ {
+	return NewBlockCursorsQ(c.db.Clone())
+}
+
+func (c *blockCursorsQ) Get(chainId string) (*db.BlockCursor, error) {
+	var cursor db.BlockCursor
+	err := c.db.Get(&cursor, c.selector.Where(squirrel.Eq{cursorsChainId: chainId}))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return &cursor, err
+}
+
+func (c *blockCursorsQ) Upsert(cursor db.BlockCursor) error {
+	stmt := squirrel.
+		Insert(cursorsTable).
+		SetMap(map[string]interface{}{
+			cursorsChainId: cursor.ChainId,
+			cursorsBlock:   cursor.Block,
+		}).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s", cursorsChainId, cursorsBlock, cursorsBlock))
+
+	return c.db.Exec(stmt)
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/db"
 	"github.com/Bridgeless-Project/tss-svc/internal/types"
@@ -30,6 +31,7 @@ const (
 
 	depositsWithdrawalChainId = "withdrawal_chain_id"
 	depositsWithdrawalTxHash  = "withdrawal_tx_hash"
+	depositsWithdrawalTxNonce = "withdrawal_tx_nonce"
 
 	depositsWithdrawalStatus = "withdrawal_status"
 
@@ -40,6 +42,9 @@ const (
 	depositsTxData      = "tx_data"
 	depositsSubmitted   = "submitted"
 	depositsDistributed = "distributed"
+
+	depositsPendingConfirmation = "pending_confirmation"
+	depositsPendingSince        = "pending_since"
 )
 
 type depositsQ struct {
@@ -161,7 +166,15 @@ func (d *depositsQ) UpdateProcessed(data db.ProcessedDepositData) error {
 		query = query.Set(depositsTxData, *data.TxData)
 	}
 
+	if data.PendingConfirmation {
+		query = query.Set(depositsPendingSince, time.Now().UTC())
+	}
+	if data.Resubmit {
+		query = query.Set(depositsSubmitted, false)
+	}
+
 	query = query.
+		Set(depositsPendingConfirmation, data.PendingConfirmation).
 		Set(depositsWithdrawalStatus, types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED).
 		Where(identifierToPredicate(data.Identifier))
 
@@ -184,6 +197,31 @@ func (d *depositsQ) UpdateDistributedStatus(identifier db.DepositIdentifier, dis
 	return d.db.Exec(query)
 }
 
+func (d *depositsQ) UpdatePendingConfirmation(identifier db.DepositIdentifier, pending bool) error {
+	query := squirrel.Update(depositsTable).
+		Set(depositsPendingConfirmation, pending).
+		Where(identifierToPredicate(identifier))
+
+	return d.db.Exec(query)
+}
+
+func (d *depositsQ) UpdateWithdrawalTxHash(identifier db.DepositIdentifier, hash string) error {
+	query := squirrel.Update(depositsTable).
+		Set(depositsWithdrawalTxHash, hash).
+		Set(depositsSubmitted, false).
+		Where(identifierToPredicate(identifier))
+
+	return d.db.Exec(query)
+}
+
+func (d *depositsQ) UpdateWithdrawalTxNonce(identifier db.DepositIdentifier, nonce int64) error {
+	query := squirrel.Update(depositsTable).
+		Set(depositsWithdrawalTxNonce, nonce).
+		Where(identifierToPredicate(identifier))
+
+	return d.db.Exec(query)
+}
+
 func NewDepositsQ(db *pgdb.DB) db.DepositsQ {
 	return &depositsQ{
 		db:       db.Clone(),
@@ -217,8 +255,16 @@ func (d *depositsQ) applySelector(selector db.DepositsSelector, sql squirrel.Sel
 	if selector.NotDistributed {
 		sql = sql.Where(squirrel.Eq{depositsDistributed: false})
 	}
+	if selector.WithdrawalTxHash != nil {
+		sql = sql.Where(squirrel.Eq{depositsWithdrawalTxHash: *selector.WithdrawalTxHash})
+	}
+	if selector.PendingConfirmation {
+		sql = sql.Where(squirrel.Eq{depositsPendingConfirmation: true})
+	}
 	if selector.One {
 		sql = sql.OrderBy(fmt.Sprintf("%s ASC", depositsId)).Limit(1)
+	} else if selector.Limit > 0 {
+		sql = sql.OrderBy(fmt.Sprintf("%s ASC", depositsId)).Limit(selector.Limit)
 	}
 
 	return sql
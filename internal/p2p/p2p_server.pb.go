@@ -83,13 +83,16 @@ func (PartyStatus) EnumDescriptor() ([]byte, []int) {
 type RequestType int32
 
 const (
-	RequestType_RT_KEYGEN                 RequestType = 0
-	RequestType_RT_SIGN                   RequestType = 1
-	RequestType_RT_PROPOSAL               RequestType = 2
-	RequestType_RT_ACCEPTANCE             RequestType = 3
-	RequestType_RT_SIGN_START             RequestType = 4
-	RequestType_RT_DEPOSIT_DISTRIBUTION   RequestType = 5
-	RequestType_RT_SIGNATURE_DISTRIBUTION RequestType = 6
+	RequestType_RT_KEYGEN                       RequestType = 0
+	RequestType_RT_SIGN                         RequestType = 1
+	RequestType_RT_PROPOSAL                     RequestType = 2
+	RequestType_RT_ACCEPTANCE                   RequestType = 3
+	RequestType_RT_SIGN_START                   RequestType = 4
+	RequestType_RT_DEPOSIT_DISTRIBUTION         RequestType = 5
+	RequestType_RT_SIGNATURE_DISTRIBUTION       RequestType = 6
+	RequestType_RT_RESHARE                      RequestType = 7
+	RequestType_RT_DEPOSIT_ADDRESS_DISTRIBUTION RequestType = 8
+	RequestType_RT_SHARE_COMMIT                 RequestType = 9
 )
 
 // Enum value maps for RequestType.
@@ -102,15 +105,21 @@ var (
 		4: "RT_SIGN_START",
 		5: "RT_DEPOSIT_DISTRIBUTION",
 		6: "RT_SIGNATURE_DISTRIBUTION",
+		7: "RT_RESHARE",
+		8: "RT_DEPOSIT_ADDRESS_DISTRIBUTION",
+		9: "RT_SHARE_COMMIT",
 	}
 	RequestType_value = map[string]int32{
-		"RT_KEYGEN":                 0,
-		"RT_SIGN":                   1,
-		"RT_PROPOSAL":               2,
-		"RT_ACCEPTANCE":             3,
-		"RT_SIGN_START":             4,
-		"RT_DEPOSIT_DISTRIBUTION":   5,
-		"RT_SIGNATURE_DISTRIBUTION": 6,
+		"RT_KEYGEN":                       0,
+		"RT_SIGN":                         1,
+		"RT_PROPOSAL":                     2,
+		"RT_ACCEPTANCE":                   3,
+		"RT_SIGN_START":                   4,
+		"RT_DEPOSIT_DISTRIBUTION":         5,
+		"RT_SIGNATURE_DISTRIBUTION":       6,
+		"RT_RESHARE":                      7,
+		"RT_DEPOSIT_ADDRESS_DISTRIBUTION": 8,
+		"RT_SHARE_COMMIT":                 9,
 	}
 )
 
@@ -366,11 +375,16 @@ func (x *SubmitRequest) GetData() *anypb.Any {
 }
 
 type TssData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	IsBroadcast   bool                   `protobuf:"varint,2,opt,name=isBroadcast,proto3" json:"isBroadcast,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Data        []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	IsBroadcast bool                   `protobuf:"varint,2,opt,name=isBroadcast,proto3" json:"isBroadcast,omitempty"`
+	// index of the signing party within the batch signing session
+	Index int32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// committee roles of the sender and the receiver within the key resharing session
+	FromOldCommittee bool `protobuf:"varint,4,opt,name=fromOldCommittee,proto3" json:"fromOldCommittee,omitempty"`
+	ToOldCommittee   bool `protobuf:"varint,5,opt,name=toOldCommittee,proto3" json:"toOldCommittee,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TssData) Reset() {
@@ -417,6 +431,27 @@ func (x *TssData) GetIsBroadcast() bool {
 	return false
 }
 
+func (x *TssData) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TssData) GetFromOldCommittee() bool {
+	if x != nil {
+		return x.FromOldCommittee
+	}
+	return false
+}
+
+func (x *TssData) GetToOldCommittee() bool {
+	if x != nil {
+		return x.ToOldCommittee
+	}
+	return false
+}
+
 type SignStartData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Parties       []string               `protobuf:"bytes,1,rep,name=parties,proto3" json:"parties,omitempty"`
@@ -505,7 +540,7 @@ func (x *AcceptanceData) GetAccepted() bool {
 	return false
 }
 
-type EvmProposalData struct {
+type DepositSigData struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	DepositId     *types.DepositIdentifier `protobuf:"bytes,1,opt,name=depositId,proto3" json:"depositId,omitempty"`
 	SigData       []byte                   `protobuf:"bytes,2,opt,name=sigData,proto3" json:"sigData,omitempty"`
@@ -513,20 +548,20 @@ type EvmProposalData struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvmProposalData) Reset() {
-	*x = EvmProposalData{}
+func (x *DepositSigData) Reset() {
+	*x = DepositSigData{}
 	mi := &file_p2p_server_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvmProposalData) String() string {
+func (x *DepositSigData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvmProposalData) ProtoMessage() {}
+func (*DepositSigData) ProtoMessage() {}
 
-func (x *EvmProposalData) ProtoReflect() protoreflect.Message {
+func (x *DepositSigData) ProtoReflect() protoreflect.Message {
 	mi := &file_p2p_server_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -538,47 +573,46 @@ func (x *EvmProposalData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvmProposalData.ProtoReflect.Descriptor instead.
-func (*EvmProposalData) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositSigData.ProtoReflect.Descriptor instead.
+func (*DepositSigData) Descriptor() ([]byte, []int) {
 	return file_p2p_server_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EvmProposalData) GetDepositId() *types.DepositIdentifier {
+func (x *DepositSigData) GetDepositId() *types.DepositIdentifier {
 	if x != nil {
 		return x.DepositId
 	}
 	return nil
 }
 
-func (x *EvmProposalData) GetSigData() []byte {
+func (x *DepositSigData) GetSigData() []byte {
 	if x != nil {
 		return x.SigData
 	}
 	return nil
 }
 
-type TonProposalData struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	DepositId     *types.DepositIdentifier `protobuf:"bytes,1,opt,name=depositId,proto3" json:"depositId,omitempty"`
-	SigData       []byte                   `protobuf:"bytes,2,opt,name=sigData,proto3" json:"sigData,omitempty"`
+type EvmProposalData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deposits      []*DepositSigData      `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TonProposalData) Reset() {
-	*x = TonProposalData{}
+func (x *EvmProposalData) Reset() {
+	*x = EvmProposalData{}
 	mi := &file_p2p_server_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TonProposalData) String() string {
+func (x *EvmProposalData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TonProposalData) ProtoMessage() {}
+func (*EvmProposalData) ProtoMessage() {}
 
-func (x *TonProposalData) ProtoReflect() protoreflect.Message {
+func (x *EvmProposalData) ProtoReflect() protoreflect.Message {
 	mi := &file_p2p_server_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,36 +624,72 @@ func (x *TonProposalData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TonProposalData.ProtoReflect.Descriptor instead.
-func (*TonProposalData) Descriptor() ([]byte, []int) {
+// Deprecated: Use EvmProposalData.ProtoReflect.Descriptor instead.
+func (*EvmProposalData) Descriptor() ([]byte, []int) {
 	return file_p2p_server_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TonProposalData) GetDepositId() *types.DepositIdentifier {
+func (x *EvmProposalData) GetDeposits() []*DepositSigData {
 	if x != nil {
-		return x.DepositId
+		return x.Deposits
 	}
 	return nil
 }
 
-func (x *TonProposalData) GetSigData() []byte {
+type TonProposalData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deposits      []*DepositSigData      `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TonProposalData) Reset() {
+	*x = TonProposalData{}
+	mi := &file_p2p_server_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TonProposalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TonProposalData) ProtoMessage() {}
+
+func (x *TonProposalData) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_server_proto_msgTypes[9]
 	if x != nil {
-		return x.SigData
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TonProposalData.ProtoReflect.Descriptor instead.
+func (*TonProposalData) Descriptor() ([]byte, []int) {
+	return file_p2p_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TonProposalData) GetDeposits() []*DepositSigData {
+	if x != nil {
+		return x.Deposits
 	}
 	return nil
 }
 
 type SolanaProposalData struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	DepositId     *types.DepositIdentifier `protobuf:"bytes,1,opt,name=depositId,proto3" json:"depositId,omitempty"`
-	SigData       []byte                   `protobuf:"bytes,2,opt,name=sigData,proto3" json:"sigData,omitempty"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deposits      []*DepositSigData      `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SolanaProposalData) Reset() {
 	*x = SolanaProposalData{}
-	mi := &file_p2p_server_proto_msgTypes[9]
+	mi := &file_p2p_server_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -631,7 +701,7 @@ func (x *SolanaProposalData) String() string {
 func (*SolanaProposalData) ProtoMessage() {}
 
 func (x *SolanaProposalData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_server_proto_msgTypes[9]
+	mi := &file_p2p_server_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,19 +714,12 @@ func (x *SolanaProposalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SolanaProposalData.ProtoReflect.Descriptor instead.
 func (*SolanaProposalData) Descriptor() ([]byte, []int) {
-	return file_p2p_server_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *SolanaProposalData) GetDepositId() *types.DepositIdentifier {
-	if x != nil {
-		return x.DepositId
-	}
-	return nil
+	return file_p2p_server_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SolanaProposalData) GetSigData() []byte {
+func (x *SolanaProposalData) GetDeposits() []*DepositSigData {
 	if x != nil {
-		return x.SigData
+		return x.Deposits
 	}
 	return nil
 }
@@ -677,7 +740,7 @@ type ZanoProposalData struct {
 
 func (x *ZanoProposalData) Reset() {
 	*x = ZanoProposalData{}
-	mi := &file_p2p_server_proto_msgTypes[10]
+	mi := &file_p2p_server_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +752,7 @@ func (x *ZanoProposalData) String() string {
 func (*ZanoProposalData) ProtoMessage() {}
 
 func (x *ZanoProposalData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_server_proto_msgTypes[10]
+	mi := &file_p2p_server_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +765,7 @@ func (x *ZanoProposalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZanoProposalData.ProtoReflect.Descriptor instead.
 func (*ZanoProposalData) Descriptor() ([]byte, []int) {
-	return file_p2p_server_proto_rawDescGZIP(), []int{10}
+	return file_p2p_server_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ZanoProposalData) GetDepositId() *types.DepositIdentifier {
@@ -755,18 +818,23 @@ func (x *ZanoProposalData) GetSigData() []byte {
 }
 
 type BitcoinProposalData struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	DepositId     *types.DepositIdentifier `protobuf:"bytes,1,opt,name=depositId,proto3" json:"depositId,omitempty"`
-	SerializedTx  []byte                   `protobuf:"bytes,2,opt,name=serializedTx,proto3" json:"serializedTx,omitempty"`
-	FeeRate       int64                    `protobuf:"varint,3,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
-	SigData       [][]byte                 `protobuf:"bytes,4,rep,name=sigData,proto3" json:"sigData,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deposits withdrawn by the transaction, ordered the same way as the receiver outputs
+	DepositIds   []*types.DepositIdentifier `protobuf:"bytes,5,rep,name=depositIds,proto3" json:"depositIds,omitempty"`
+	SerializedTx []byte                     `protobuf:"bytes,2,opt,name=serializedTx,proto3" json:"serializedTx,omitempty"`
+	FeeRate      int64                      `protobuf:"varint,3,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	SigData      [][]byte                   `protobuf:"bytes,4,rep,name=sigData,proto3" json:"sigData,omitempty"`
+	// scripts of the outputs spent by the transaction inputs
+	PrevScripts [][]byte `protobuf:"bytes,6,rep,name=prevScripts,proto3" json:"prevScripts,omitempty"`
+	// hash of the stuck transaction replaced by this one (BIP-125), empty for regular withdrawals
+	ReplacedTxHash string `protobuf:"bytes,7,opt,name=replacedTxHash,proto3" json:"replacedTxHash,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BitcoinProposalData) Reset() {
 	*x = BitcoinProposalData{}
-	mi := &file_p2p_server_proto_msgTypes[11]
+	mi := &file_p2p_server_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -778,7 +846,7 @@ func (x *BitcoinProposalData) String() string {
 func (*BitcoinProposalData) ProtoMessage() {}
 
 func (x *BitcoinProposalData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_server_proto_msgTypes[11]
+	mi := &file_p2p_server_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,12 +859,12 @@ func (x *BitcoinProposalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BitcoinProposalData.ProtoReflect.Descriptor instead.
 func (*BitcoinProposalData) Descriptor() ([]byte, []int) {
-	return file_p2p_server_proto_rawDescGZIP(), []int{11}
+	return file_p2p_server_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BitcoinProposalData) GetDepositId() *types.DepositIdentifier {
+func (x *BitcoinProposalData) GetDepositIds() []*types.DepositIdentifier {
 	if x != nil {
-		return x.DepositId
+		return x.DepositIds
 	}
 	return nil
 }
@@ -822,17 +890,171 @@ func (x *BitcoinProposalData) GetSigData() [][]byte {
 	return nil
 }
 
+func (x *BitcoinProposalData) GetPrevScripts() [][]byte {
+	if x != nil {
+		return x.PrevScripts
+	}
+	return nil
+}
+
+func (x *BitcoinProposalData) GetReplacedTxHash() string {
+	if x != nil {
+		return x.ReplacedTxHash
+	}
+	return ""
+}
+
+type CosmosProposalData struct {
+	state     protoimpl.MessageState   `protogen:"open.v1"`
+	DepositId *types.DepositIdentifier `protobuf:"bytes,1,opt,name=depositId,proto3" json:"depositId,omitempty"`
+	// serialized TxBody and AuthInfo of the withdrawal transaction
+	BodyBytes     []byte `protobuf:"bytes,2,opt,name=bodyBytes,proto3" json:"bodyBytes,omitempty"`
+	AuthInfoBytes []byte `protobuf:"bytes,3,opt,name=authInfoBytes,proto3" json:"authInfoBytes,omitempty"`
+	AccountNumber uint64 `protobuf:"varint,4,opt,name=accountNumber,proto3" json:"accountNumber,omitempty"`
+	// hash of the SIGN_MODE_DIRECT sign doc
+	SigData       []byte `protobuf:"bytes,5,opt,name=sigData,proto3" json:"sigData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CosmosProposalData) Reset() {
+	*x = CosmosProposalData{}
+	mi := &file_p2p_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CosmosProposalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosProposalData) ProtoMessage() {}
+
+func (x *CosmosProposalData) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosProposalData.ProtoReflect.Descriptor instead.
+func (*CosmosProposalData) Descriptor() ([]byte, []int) {
+	return file_p2p_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CosmosProposalData) GetDepositId() *types.DepositIdentifier {
+	if x != nil {
+		return x.DepositId
+	}
+	return nil
+}
+
+func (x *CosmosProposalData) GetBodyBytes() []byte {
+	if x != nil {
+		return x.BodyBytes
+	}
+	return nil
+}
+
+func (x *CosmosProposalData) GetAuthInfoBytes() []byte {
+	if x != nil {
+		return x.AuthInfoBytes
+	}
+	return nil
+}
+
+func (x *CosmosProposalData) GetAccountNumber() uint64 {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return 0
+}
+
+func (x *CosmosProposalData) GetSigData() []byte {
+	if x != nil {
+		return x.SigData
+	}
+	return nil
+}
+
+type PluginProposalData struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	DepositIds    []*types.DepositIdentifier `protobuf:"bytes,1,rep,name=depositIds,proto3" json:"depositIds,omitempty"`
+	Data          []byte                     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	SigHashes     [][]byte                   `protobuf:"bytes,3,rep,name=sigHashes,proto3" json:"sigHashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginProposalData) Reset() {
+	*x = PluginProposalData{}
+	mi := &file_p2p_server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginProposalData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginProposalData) ProtoMessage() {}
+
+func (x *PluginProposalData) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginProposalData.ProtoReflect.Descriptor instead.
+func (*PluginProposalData) Descriptor() ([]byte, []int) {
+	return file_p2p_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PluginProposalData) GetDepositIds() []*types.DepositIdentifier {
+	if x != nil {
+		return x.DepositIds
+	}
+	return nil
+}
+
+func (x *PluginProposalData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PluginProposalData) GetSigHashes() [][]byte {
+	if x != nil {
+		return x.SigHashes
+	}
+	return nil
+}
+
 type BitcoinResharingProposalData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SerializedTx  []byte                 `protobuf:"bytes,1,opt,name=serializedTx,proto3" json:"serializedTx,omitempty"`
-	SigData       [][]byte               `protobuf:"bytes,2,rep,name=sigData,proto3" json:"sigData,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SerializedTx []byte                 `protobuf:"bytes,1,opt,name=serializedTx,proto3" json:"serializedTx,omitempty"`
+	SigData      [][]byte               `protobuf:"bytes,2,rep,name=sigData,proto3" json:"sigData,omitempty"`
+	// scripts of the outputs spent by the transaction inputs
+	PrevScripts   [][]byte `protobuf:"bytes,3,rep,name=prevScripts,proto3" json:"prevScripts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BitcoinResharingProposalData) Reset() {
 	*x = BitcoinResharingProposalData{}
-	mi := &file_p2p_server_proto_msgTypes[12]
+	mi := &file_p2p_server_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1066,7 @@ func (x *BitcoinResharingProposalData) String() string {
 func (*BitcoinResharingProposalData) ProtoMessage() {}
 
 func (x *BitcoinResharingProposalData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_server_proto_msgTypes[12]
+	mi := &file_p2p_server_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1079,7 @@ func (x *BitcoinResharingProposalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BitcoinResharingProposalData.ProtoReflect.Descriptor instead.
 func (*BitcoinResharingProposalData) Descriptor() ([]byte, []int) {
-	return file_p2p_server_proto_rawDescGZIP(), []int{12}
+	return file_p2p_server_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BitcoinResharingProposalData) GetSerializedTx() []byte {
@@ -874,6 +1096,13 @@ func (x *BitcoinResharingProposalData) GetSigData() [][]byte {
 	return nil
 }
 
+func (x *BitcoinResharingProposalData) GetPrevScripts() [][]byte {
+	if x != nil {
+		return x.PrevScripts
+	}
+	return nil
+}
+
 type ZanoResharingProposalData struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	AssetId        string                 `protobuf:"bytes,1,opt,name=assetId,proto3" json:"assetId,omitempty"`
@@ -891,7 +1120,7 @@ type ZanoResharingProposalData struct {
 
 func (x *ZanoResharingProposalData) Reset() {
 	*x = ZanoResharingProposalData{}
-	mi := &file_p2p_server_proto_msgTypes[13]
+	mi := &file_p2p_server_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -903,7 +1132,7 @@ func (x *ZanoResharingProposalData) String() string {
 func (*ZanoResharingProposalData) ProtoMessage() {}
 
 func (x *ZanoResharingProposalData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_server_proto_msgTypes[13]
+	mi := &file_p2p_server_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,7 +1145,7 @@ func (x *ZanoResharingProposalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZanoResharingProposalData.ProtoReflect.Descriptor instead.
 func (*ZanoResharingProposalData) Descriptor() ([]byte, []int) {
-	return file_p2p_server_proto_rawDescGZIP(), []int{13}
+	return file_p2p_server_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ZanoResharingProposalData) GetAssetId() string {
@@ -984,7 +1213,7 @@ type DepositDistributionData struct {
 
 func (x *DepositDistributionData) Reset() {
 	*x = DepositDistributionData{}
-	mi := &file_p2p_server_proto_msgTypes[14]
+	mi := &file_p2p_server_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -996,7 +1225,7 @@ func (x *DepositDistributionData) String() string {
 func (*DepositDistributionData) ProtoMessage() {}
 
 func (x *DepositDistributionData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_server_proto_msgTypes[14]
+	mi := &file_p2p_server_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1238,7 @@ func (x *DepositDistributionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositDistributionData.ProtoReflect.Descriptor instead.
 func (*DepositDistributionData) Descriptor() ([]byte, []int) {
-	return file_p2p_server_proto_rawDescGZIP(), []int{14}
+	return file_p2p_server_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DepositDistributionData) GetDepositId() *types.DepositIdentifier {
@@ -1019,6 +1248,66 @@ func (x *DepositDistributionData) GetDepositId() *types.DepositIdentifier {
 	return nil
 }
 
+type DepositAddressDistributionData struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ChainId            string                 `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	DestinationChainId string                 `protobuf:"bytes,2,opt,name=destinationChainId,proto3" json:"destinationChainId,omitempty"`
+	Receiver           string                 `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DepositAddressDistributionData) Reset() {
+	*x = DepositAddressDistributionData{}
+	mi := &file_p2p_server_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositAddressDistributionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAddressDistributionData) ProtoMessage() {}
+
+func (x *DepositAddressDistributionData) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_server_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAddressDistributionData.ProtoReflect.Descriptor instead.
+func (*DepositAddressDistributionData) Descriptor() ([]byte, []int) {
+	return file_p2p_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DepositAddressDistributionData) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *DepositAddressDistributionData) GetDestinationChainId() string {
+	if x != nil {
+		return x.DestinationChainId
+	}
+	return ""
+}
+
+func (x *DepositAddressDistributionData) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
 type ReliableBroadcastData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoundMsg      []byte                 `protobuf:"bytes,1,opt,name=roundMsg,proto3" json:"roundMsg,omitempty"`
@@ -1028,7 +1317,7 @@ type ReliableBroadcastData struct {
 
 func (x *ReliableBroadcastData) Reset() {
 	*x = ReliableBroadcastData{}
-	mi := &file_p2p_server_proto_msgTypes[15]
+	mi := &file_p2p_server_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1040,7 +1329,7 @@ func (x *ReliableBroadcastData) String() string {
 func (*ReliableBroadcastData) ProtoMessage() {}
 
 func (x *ReliableBroadcastData) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_server_proto_msgTypes[15]
+	mi := &file_p2p_server_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,7 +1342,7 @@ func (x *ReliableBroadcastData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReliableBroadcastData.ProtoReflect.Descriptor instead.
 func (*ReliableBroadcastData) Descriptor() ([]byte, []int) {
-	return file_p2p_server_proto_rawDescGZIP(), []int{15}
+	return file_p2p_server_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReliableBroadcastData) GetRoundMsg() []byte {
@@ -1081,23 +1370,26 @@ const file_p2p_server_proto_rawDesc = "" +
 	"\x06sender\x18\x01 \x01(\tR\x06sender\x12\x1c\n" +
 	"\tsessionId\x18\x02 \x01(\tR\tsessionId\x12$\n" +
 	"\x04type\x18\x03 \x01(\x0e2\x10.p2p.RequestTypeR\x04type\x12(\n" +
-	"\x04data\x18\x04 \x01(\v2\x14.google.protobuf.AnyR\x04data\"?\n" +
+	"\x04data\x18\x04 \x01(\v2\x14.google.protobuf.AnyR\x04data\"\xa9\x01\n" +
 	"\aTssData\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12 \n" +
-	"\visBroadcast\x18\x02 \x01(\bR\visBroadcast\")\n" +
+	"\visBroadcast\x18\x02 \x01(\bR\visBroadcast\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\x05R\x05index\x12*\n" +
+	"\x10fromOldCommittee\x18\x04 \x01(\bR\x10fromOldCommittee\x12&\n" +
+	"\x0etoOldCommittee\x18\x05 \x01(\bR\x0etoOldCommittee\")\n" +
 	"\rSignStartData\x12\x18\n" +
 	"\aparties\x18\x01 \x03(\tR\aparties\",\n" +
 	"\x0eAcceptanceData\x12\x1a\n" +
-	"\baccepted\x18\x01 \x01(\bR\baccepted\"k\n" +
-	"\x0fEvmProposalData\x12>\n" +
-	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\x12\x18\n" +
-	"\asigData\x18\x02 \x01(\fR\asigData\"k\n" +
-	"\x0fTonProposalData\x12>\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\"j\n" +
+	"\x0eDepositSigData\x12>\n" +
 	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\x12\x18\n" +
-	"\asigData\x18\x02 \x01(\fR\asigData\"n\n" +
-	"\x12SolanaProposalData\x12>\n" +
-	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\x12\x18\n" +
-	"\asigData\x18\x02 \x01(\fR\asigData\"\x90\x02\n" +
+	"\asigData\x18\x02 \x01(\fR\asigData\"N\n" +
+	"\x0fEvmProposalData\x12/\n" +
+	"\bdeposits\x18\x03 \x03(\v2\x13.p2p.DepositSigDataR\bdepositsJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"N\n" +
+	"\x0fTonProposalData\x12/\n" +
+	"\bdeposits\x18\x03 \x03(\v2\x13.p2p.DepositSigDataR\bdepositsJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"Q\n" +
+	"\x12SolanaProposalData\x12/\n" +
+	"\bdeposits\x18\x03 \x03(\v2\x13.p2p.DepositSigDataR\bdepositsJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\x90\x02\n" +
 	"\x10ZanoProposalData\x12>\n" +
 	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\x12*\n" +
 	"\x10outputsAddresses\x18\x02 \x03(\tR\x10outputsAddresses\x12\x1e\n" +
@@ -1107,15 +1399,32 @@ const file_p2p_server_proto_rawDesc = "" +
 	"\vfinalizedTx\x18\x04 \x01(\tR\vfinalizedTx\x12 \n" +
 	"\vtxSecretKey\x18\x05 \x01(\tR\vtxSecretKey\x12\x12\n" +
 	"\x04txId\x18\x06 \x01(\tR\x04txId\x12\x18\n" +
-	"\asigData\x18\a \x01(\fR\asigData\"\xad\x01\n" +
-	"\x13BitcoinProposalData\x12>\n" +
-	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\x12\"\n" +
+	"\asigData\x18\a \x01(\fR\asigData\"\xf9\x01\n" +
+	"\x13BitcoinProposalData\x12:\n" +
+	"\n" +
+	"depositIds\x18\x05 \x03(\v2\x1a.deposit.DepositIdentifierR\n" +
+	"depositIds\x12\"\n" +
 	"\fserializedTx\x18\x02 \x01(\fR\fserializedTx\x12\x18\n" +
 	"\afeeRate\x18\x03 \x01(\x03R\afeeRate\x12\x18\n" +
-	"\asigData\x18\x04 \x03(\fR\asigData\"\\\n" +
+	"\asigData\x18\x04 \x03(\fR\asigData\x12 \n" +
+	"\vprevScripts\x18\x06 \x03(\fR\vprevScripts\x12&\n" +
+	"\x0ereplacedTxHash\x18\a \x01(\tR\x0ereplacedTxHashJ\x04\b\x01\x10\x02\"\xd8\x01\n" +
+	"\x12CosmosProposalData\x12>\n" +
+	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\x12\x1c\n" +
+	"\tbodyBytes\x18\x02 \x01(\fR\tbodyBytes\x12$\n" +
+	"\rauthInfoBytes\x18\x03 \x01(\fR\rauthInfoBytes\x12$\n" +
+	"\raccountNumber\x18\x04 \x01(\x04R\raccountNumber\x12\x18\n" +
+	"\asigData\x18\x05 \x01(\fR\asigData\"\x82\x01\n" +
+	"\x12PluginProposalData\x12:\n" +
+	"\n" +
+	"depositIds\x18\x01 \x03(\v2\x1a.deposit.DepositIdentifierR\n" +
+	"depositIds\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1c\n" +
+	"\tsigHashes\x18\x03 \x03(\fR\tsigHashes\"~\n" +
 	"\x1cBitcoinResharingProposalData\x12\"\n" +
 	"\fserializedTx\x18\x01 \x01(\fR\fserializedTx\x12\x18\n" +
-	"\asigData\x18\x02 \x03(\fR\asigData\"\x9b\x02\n" +
+	"\asigData\x18\x02 \x03(\fR\asigData\x12 \n" +
+	"\vprevScripts\x18\x03 \x03(\fR\vprevScripts\"\x9b\x02\n" +
 	"\x19ZanoResharingProposalData\x12\x18\n" +
 	"\aassetId\x18\x01 \x01(\tR\aassetId\x12&\n" +
 	"\x0eownerEthPubKey\x18\x02 \x01(\tR\x0eownerEthPubKey\x12*\n" +
@@ -1128,7 +1437,11 @@ const file_p2p_server_proto_rawDesc = "" +
 	"\x04txId\x18\a \x01(\tR\x04txId\x12\x18\n" +
 	"\asigData\x18\b \x01(\fR\asigData\"Y\n" +
 	"\x17DepositDistributionData\x12>\n" +
-	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\"3\n" +
+	"\tdepositId\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierB\x04\xc8\xde\x1f\x00R\tdepositId\"\x86\x01\n" +
+	"\x1eDepositAddressDistributionData\x12\x18\n" +
+	"\achainId\x18\x01 \x01(\tR\achainId\x12.\n" +
+	"\x12destinationChainId\x18\x02 \x01(\tR\x12destinationChainId\x12\x1a\n" +
+	"\breceiver\x18\x03 \x01(\tR\breceiver\"3\n" +
 	"\x15ReliableBroadcastData\x12\x1a\n" +
 	"\broundMsg\x18\x01 \x01(\fR\broundMsg*V\n" +
 	"\vPartyStatus\x12\x0e\n" +
@@ -1138,7 +1451,7 @@ const file_p2p_server_proto_rawDesc = "" +
 	"\aPS_SIGN\x10\x02\x12\x0e\n" +
 	"\n" +
 	"PS_RESHARE\x10\x03\x12\v\n" +
-	"\aPS_SYNC\x10\x04*\x9c\x01\n" +
+	"\aPS_SYNC\x10\x04*\xe6\x01\n" +
 	"\vRequestType\x12\r\n" +
 	"\tRT_KEYGEN\x10\x00\x12\v\n" +
 	"\aRT_SIGN\x10\x01\x12\x0f\n" +
@@ -1146,7 +1459,11 @@ const file_p2p_server_proto_rawDesc = "" +
 	"\rRT_ACCEPTANCE\x10\x03\x12\x11\n" +
 	"\rRT_SIGN_START\x10\x04\x12\x1b\n" +
 	"\x17RT_DEPOSIT_DISTRIBUTION\x10\x05\x12\x1d\n" +
-	"\x19RT_SIGNATURE_DISTRIBUTION\x10\x062\xca\x01\n" +
+	"\x19RT_SIGNATURE_DISTRIBUTION\x10\x06\x12\x0e\n" +
+	"\n" +
+	"RT_RESHARE\x10\a\x12#\n" +
+	"\x1fRT_DEPOSIT_ADDRESS_DISTRIBUTION\x10\b\x12\x13\n" +
+	"\x0fRT_SHARE_COMMIT\x10\t2\xca\x01\n" +
 	"\x03P2P\x127\n" +
 	"\x06Status\x12\x16.google.protobuf.Empty\x1a\x13.p2p.StatusResponse\"\x00\x126\n" +
 	"\x06Submit\x12\x12.p2p.SubmitRequest\x1a\x16.google.protobuf.Empty\"\x00\x12R\n" +
@@ -1165,51 +1482,58 @@ func file_p2p_server_proto_rawDescGZIP() []byte {
 }
 
 var file_p2p_server_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_p2p_server_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_p2p_server_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_p2p_server_proto_goTypes = []any{
-	(PartyStatus)(0),                     // 0: p2p.PartyStatus
-	(RequestType)(0),                     // 1: p2p.RequestType
-	(*SigningSessionInfoRequest)(nil),    // 2: p2p.SigningSessionInfoRequest
-	(*SigningSessionInfo)(nil),           // 3: p2p.SigningSessionInfo
-	(*StatusResponse)(nil),               // 4: p2p.StatusResponse
-	(*SubmitRequest)(nil),                // 5: p2p.SubmitRequest
-	(*TssData)(nil),                      // 6: p2p.TssData
-	(*SignStartData)(nil),                // 7: p2p.SignStartData
-	(*AcceptanceData)(nil),               // 8: p2p.AcceptanceData
-	(*EvmProposalData)(nil),              // 9: p2p.EvmProposalData
-	(*TonProposalData)(nil),              // 10: p2p.TonProposalData
-	(*SolanaProposalData)(nil),           // 11: p2p.SolanaProposalData
-	(*ZanoProposalData)(nil),             // 12: p2p.ZanoProposalData
-	(*BitcoinProposalData)(nil),          // 13: p2p.BitcoinProposalData
-	(*BitcoinResharingProposalData)(nil), // 14: p2p.BitcoinResharingProposalData
-	(*ZanoResharingProposalData)(nil),    // 15: p2p.ZanoResharingProposalData
-	(*DepositDistributionData)(nil),      // 16: p2p.DepositDistributionData
-	(*ReliableBroadcastData)(nil),        // 17: p2p.ReliableBroadcastData
-	(*anypb.Any)(nil),                    // 18: google.protobuf.Any
-	(*types.DepositIdentifier)(nil),      // 19: deposit.DepositIdentifier
-	(*emptypb.Empty)(nil),                // 20: google.protobuf.Empty
+	(PartyStatus)(0),                       // 0: p2p.PartyStatus
+	(RequestType)(0),                       // 1: p2p.RequestType
+	(*SigningSessionInfoRequest)(nil),      // 2: p2p.SigningSessionInfoRequest
+	(*SigningSessionInfo)(nil),             // 3: p2p.SigningSessionInfo
+	(*StatusResponse)(nil),                 // 4: p2p.StatusResponse
+	(*SubmitRequest)(nil),                  // 5: p2p.SubmitRequest
+	(*TssData)(nil),                        // 6: p2p.TssData
+	(*SignStartData)(nil),                  // 7: p2p.SignStartData
+	(*AcceptanceData)(nil),                 // 8: p2p.AcceptanceData
+	(*DepositSigData)(nil),                 // 9: p2p.DepositSigData
+	(*EvmProposalData)(nil),                // 10: p2p.EvmProposalData
+	(*TonProposalData)(nil),                // 11: p2p.TonProposalData
+	(*SolanaProposalData)(nil),             // 12: p2p.SolanaProposalData
+	(*ZanoProposalData)(nil),               // 13: p2p.ZanoProposalData
+	(*BitcoinProposalData)(nil),            // 14: p2p.BitcoinProposalData
+	(*CosmosProposalData)(nil),             // 15: p2p.CosmosProposalData
+	(*PluginProposalData)(nil),             // 16: p2p.PluginProposalData
+	(*BitcoinResharingProposalData)(nil),   // 17: p2p.BitcoinResharingProposalData
+	(*ZanoResharingProposalData)(nil),      // 18: p2p.ZanoResharingProposalData
+	(*DepositDistributionData)(nil),        // 19: p2p.DepositDistributionData
+	(*DepositAddressDistributionData)(nil), // 20: p2p.DepositAddressDistributionData
+	(*ReliableBroadcastData)(nil),          // 21: p2p.ReliableBroadcastData
+	(*anypb.Any)(nil),                      // 22: google.protobuf.Any
+	(*types.DepositIdentifier)(nil),        // 23: deposit.DepositIdentifier
+	(*emptypb.Empty)(nil),                  // 24: google.protobuf.Empty
 }
 var file_p2p_server_proto_depIdxs = []int32{
 	0,  // 0: p2p.StatusResponse.status:type_name -> p2p.PartyStatus
 	1,  // 1: p2p.SubmitRequest.type:type_name -> p2p.RequestType
-	18, // 2: p2p.SubmitRequest.data:type_name -> google.protobuf.Any
-	19, // 3: p2p.EvmProposalData.depositId:type_name -> deposit.DepositIdentifier
-	19, // 4: p2p.TonProposalData.depositId:type_name -> deposit.DepositIdentifier
-	19, // 5: p2p.SolanaProposalData.depositId:type_name -> deposit.DepositIdentifier
-	19, // 6: p2p.ZanoProposalData.depositId:type_name -> deposit.DepositIdentifier
-	19, // 7: p2p.BitcoinProposalData.depositId:type_name -> deposit.DepositIdentifier
-	19, // 8: p2p.DepositDistributionData.depositId:type_name -> deposit.DepositIdentifier
-	20, // 9: p2p.P2P.Status:input_type -> google.protobuf.Empty
-	5,  // 10: p2p.P2P.Submit:input_type -> p2p.SubmitRequest
-	2,  // 11: p2p.P2P.GetSigningSessionInfo:input_type -> p2p.SigningSessionInfoRequest
-	4,  // 12: p2p.P2P.Status:output_type -> p2p.StatusResponse
-	20, // 13: p2p.P2P.Submit:output_type -> google.protobuf.Empty
-	3,  // 14: p2p.P2P.GetSigningSessionInfo:output_type -> p2p.SigningSessionInfo
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	22, // 2: p2p.SubmitRequest.data:type_name -> google.protobuf.Any
+	23, // 3: p2p.DepositSigData.depositId:type_name -> deposit.DepositIdentifier
+	9,  // 4: p2p.EvmProposalData.deposits:type_name -> p2p.DepositSigData
+	9,  // 5: p2p.TonProposalData.deposits:type_name -> p2p.DepositSigData
+	9,  // 6: p2p.SolanaProposalData.deposits:type_name -> p2p.DepositSigData
+	23, // 7: p2p.ZanoProposalData.depositId:type_name -> deposit.DepositIdentifier
+	23, // 8: p2p.BitcoinProposalData.depositIds:type_name -> deposit.DepositIdentifier
+	23, // 9: p2p.CosmosProposalData.depositId:type_name -> deposit.DepositIdentifier
+	23, // 10: p2p.PluginProposalData.depositIds:type_name -> deposit.DepositIdentifier
+	23, // 11: p2p.DepositDistributionData.depositId:type_name -> deposit.DepositIdentifier
+	24, // 12: p2p.P2P.Status:input_type -> google.protobuf.Empty
+	5,  // 13: p2p.P2P.Submit:input_type -> p2p.SubmitRequest
+	2,  // 14: p2p.P2P.GetSigningSessionInfo:input_type -> p2p.SigningSessionInfoRequest
+	4,  // 15: p2p.P2P.Status:output_type -> p2p.StatusResponse
+	24, // 16: p2p.P2P.Submit:output_type -> google.protobuf.Empty
+	3,  // 17: p2p.P2P.GetSigningSessionInfo:output_type -> p2p.SigningSessionInfo
+	15, // [15:18] is the sub-list for method output_type
+	12, // [12:15] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_p2p_server_proto_init() }
@@ -1223,7 +1547,7 @@ func file_p2p_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_p2p_server_proto_rawDesc), len(file_p2p_server_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Bridgeless-Project/tss-svc/internal/core"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	eddsaKeygen "github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
 )
 
 type Storage interface {
@@ -16,7 +17,15 @@ type Storage interface {
 
 	SaveTssShare(data *keygen.LocalPartySaveData) error
 	GetTssShare() (*keygen.LocalPartySaveData, error)
+	// SavePreviousTssShare keeps the share replaced by the key share refresh, so it can be restored
+	SavePreviousTssShare(data *keygen.LocalPartySaveData) error
+	GetPreviousTssShare() (*keygen.LocalPartySaveData, error)
+	SaveEddsaTssShare(data *eddsaKeygen.LocalPartySaveData) error
+	GetEddsaTssShare() (*eddsaKeygen.LocalPartySaveData, error)
 
 	SaveLocalPartyTlsCertificate(rawCert, rawKey []byte) error
 	GetLocalPartyTlsCertificate() (*tls.Certificate, error)
+
+	SaveRelayerKey(chainId string, key string) error
+	GetRelayerKey(chainId string) (string, error)
 }
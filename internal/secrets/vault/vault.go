@@ -4,10 +4,12 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"fmt"
 
 	"github.com/Bridgeless-Project/tss-svc/internal/core"
 	"github.com/Bridgeless-Project/tss-svc/internal/secrets"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	eddsaKeygen "github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	client "github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
@@ -18,7 +20,10 @@ const (
 	keyPreParams = "keygen_preparams"
 	keyAccount   = "core_account"
 	keyTssShare  = "tss_share"
+	keyPrevTss   = "tss_share_previous"
+	keyEddsaTss  = "tss_share_eddsa"
 	keyTlsCert   = "tls_cert"
+	keyRelayer   = "relayer_key"
 	tlsCertData  = "cert_data"
 	tlsKeyData   = "key_data"
 )
@@ -84,12 +89,20 @@ func (s *Storage) SaveKeygenPreParams(params *keygen.LocalPreParams) error {
 }
 
 func (s *Storage) SaveTssShare(data *keygen.LocalPartySaveData) error {
+	return s.saveTssShare(keyTssShare, data)
+}
+
+func (s *Storage) SavePreviousTssShare(data *keygen.LocalPartySaveData) error {
+	return s.saveTssShare(keyPrevTss, data)
+}
+
+func (s *Storage) saveTssShare(path string, data *keygen.LocalPartySaveData) error {
 	raw, err := json.Marshal(data)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal share data")
 	}
 
-	return s.store(keyTssShare, map[string]interface{}{
+	return s.store(path, map[string]interface{}{
 		valueKey: string(raw),
 	})
 }
@@ -120,7 +133,15 @@ func (s *Storage) SaveCoreAccount(account *core.Account) error {
 }
 
 func (s *Storage) GetTssShare() (*keygen.LocalPartySaveData, error) {
-	kvData, err := s.load(keyTssShare)
+	return s.getTssShare(keyTssShare)
+}
+
+func (s *Storage) GetPreviousTssShare() (*keygen.LocalPartySaveData, error) {
+	return s.getTssShare(keyPrevTss)
+}
+
+func (s *Storage) getTssShare(path string) (*keygen.LocalPartySaveData, error) {
+	kvData, err := s.load(path)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load share data")
 	}
@@ -136,6 +157,34 @@ func (s *Storage) GetTssShare() (*keygen.LocalPartySaveData, error) {
 	return data, nil
 }
 
+func (s *Storage) SaveEddsaTssShare(data *eddsaKeygen.LocalPartySaveData) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal EdDSA share data")
+	}
+
+	return s.store(keyEddsaTss, map[string]interface{}{
+		valueKey: string(raw),
+	})
+}
+
+func (s *Storage) GetEddsaTssShare() (*eddsaKeygen.LocalPartySaveData, error) {
+	kvData, err := s.load(keyEddsaTss)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load EdDSA share data")
+	}
+	val, ok := kvData[valueKey].(string)
+	if !ok {
+		return nil, errors.New("EdDSA share data not found")
+	}
+	data := new(eddsaKeygen.LocalPartySaveData)
+	if err = json.Unmarshal([]byte(val), data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode EdDSA share data")
+	}
+
+	return data, nil
+}
+
 func (s *Storage) GetLocalPartyTlsCertificate() (*tls.Certificate, error) {
 	kvData, err := s.load(keyTlsCert)
 	if err != nil {
@@ -165,3 +214,26 @@ func (s *Storage) SaveLocalPartyTlsCertificate(rawCert, rawKey []byte) error {
 	})
 
 }
+
+func (s *Storage) SaveRelayerKey(chainId string, key string) error {
+	return s.store(relayerKeyPath(chainId), map[string]interface{}{
+		valueKey: key,
+	})
+}
+
+func (s *Storage) GetRelayerKey(chainId string) (string, error) {
+	kvData, err := s.load(relayerKeyPath(chainId))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to load relayer key")
+	}
+	val, ok := kvData[valueKey].(string)
+	if !ok {
+		return "", errors.New("relayer key not found")
+	}
+
+	return val, nil
+}
+
+func relayerKeyPath(chainId string) string {
+	return fmt.Sprintf("%s_%s", keyRelayer, chainId)
+}
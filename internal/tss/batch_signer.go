@@ -0,0 +1,115 @@
+package tss
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/core"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+// BatchSignParty signs multiple data simultaneously by running a separate SignParty for each of them.
+// Messages of the parties are multiplexed within the same session by the TssData index.
+type BatchSignParty struct {
+	self      LocalSignParty
+	sessionId string
+	maxSize   int
+
+	mu          sync.Mutex
+	signParties []*SignParty
+	started     bool
+
+	parties []p2p.Party
+	data    [][]byte
+
+	logger *logan.Entry
+}
+
+func NewBatchSignParty(self LocalSignParty, sessionId string, maxSize int, logger *logan.Entry) *BatchSignParty {
+	return &BatchSignParty{
+		self:      self,
+		sessionId: sessionId,
+		maxSize:   maxSize,
+		logger:    logger,
+	}
+}
+
+func (p *BatchSignParty) WithParties(parties []p2p.Party) *BatchSignParty {
+	p.parties = parties
+	return p
+}
+
+func (p *BatchSignParty) WithSigningData(data [][]byte) *BatchSignParty {
+	p.data = data
+	return p
+}
+
+func (p *BatchSignParty) Run(ctx context.Context) {
+	p.mu.Lock()
+	p.started = true
+	p.mu.Unlock()
+
+	for idx, data := range p.data {
+		p.signParty(idx).
+			WithParties(p.parties).
+			WithSigningData(data).
+			Run(ctx)
+	}
+}
+
+// WaitFor returns signatures in the same order as the signing data was provided.
+// Returns nil if any of the data was not signed.
+func (p *BatchSignParty) WaitFor() []*common.SignatureData {
+	signatures := make([]*common.SignatureData, len(p.data))
+	failed := false
+
+	for idx := range p.data {
+		signatures[idx] = p.signParty(idx).WaitFor()
+		if signatures[idx] == nil {
+			failed = true
+		}
+	}
+
+	if failed {
+		return nil
+	}
+
+	return signatures
+}
+
+func (p *BatchSignParty) Receive(sender core.Address, data *p2p.TssData) {
+	idx := int(data.Index)
+	if idx < 0 || idx >= p.maxSize {
+		p.logger.WithField("party", sender).Warn(fmt.Sprintf("got message with invalid batch index %d", idx))
+		return
+	}
+
+	p.mu.Lock()
+	outOfBatch := p.started && idx >= len(p.data)
+	p.mu.Unlock()
+	if outOfBatch {
+		p.logger.WithField("party", sender).Warn(fmt.Sprintf("got message for unknown batch index %d", idx))
+		return
+	}
+
+	p.signParty(idx).Receive(sender, data)
+}
+
+// signParty returns the party for the given index, creating it if needed,
+// as the messages from other parties can be received before the local signing starts.
+func (p *BatchSignParty) signParty(idx int) *SignParty {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.signParties) <= idx {
+		index := len(p.signParties)
+		p.signParties = append(p.signParties,
+			NewSignParty(p.self, p.sessionId, p.logger.WithField("batch_index", index)).WithIndex(int32(index)),
+		)
+	}
+
+	return p.signParties[idx]
+}
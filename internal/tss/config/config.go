@@ -1,7 +1,12 @@
 package config
 
 import (
+	"crypto/ecdsa"
+
 	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/refresh"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/figure/v3"
 	"gitlab.com/distributed_lab/kit/comfig"
@@ -12,11 +17,16 @@ const paramsConfigKey = "tss"
 
 type SessionParamsConfigurator interface {
 	TssSessionParams() session.Params
+	TssShareRefresh() refresh.Settings
+	// TssPublicKey returns the configured TSS public key or nil if it is not set.
+	TssPublicKey() *ecdsa.PublicKey
 }
 
 type configurator struct {
-	getter kv.Getter
-	once   comfig.Once
+	getter      kv.Getter
+	once        comfig.Once
+	refreshOnce comfig.Once
+	pubKeyOnce  comfig.Once
 }
 
 func NewSessionParamsConfigurator(getter kv.Getter) SessionParamsConfigurator {
@@ -39,3 +49,65 @@ func (t *configurator) TssSessionParams() session.Params {
 		return params
 	}).(session.Params)
 }
+
+func (t *configurator) TssShareRefresh() refresh.Settings {
+	return t.refreshOnce.Do(func() interface{} {
+		var cfg struct {
+			Refresh refresh.Settings `fig:"share_refresh"`
+		}
+
+		err := figure.
+			Out(&cfg).
+			With(figure.BaseHooks).
+			From(kv.MustGetStringMap(t.getter, paramsConfigKey)).
+			Please()
+		if err != nil {
+			panic(errors.Wrap(err, "failed to load tss share refresh config"))
+		}
+		if err = cfg.Refresh.Validate(); err != nil {
+			panic(errors.Wrap(err, "invalid tss share refresh config"))
+		}
+
+		return cfg.Refresh
+	}).(refresh.Settings)
+}
+
+func (t *configurator) TssPublicKey() *ecdsa.PublicKey {
+	return t.pubKeyOnce.Do(func() interface{} {
+		var cfg struct {
+			PublicKey string `fig:"public_key"`
+		}
+
+		err := figure.
+			Out(&cfg).
+			With(figure.BaseHooks).
+			From(kv.MustGetStringMap(t.getter, paramsConfigKey)).
+			Please()
+		if err != nil {
+			panic(errors.Wrap(err, "failed to load tss public key config"))
+		}
+		if cfg.PublicKey == "" {
+			return (*ecdsa.PublicKey)(nil)
+		}
+
+		pub, err := parsePublicKey(cfg.PublicKey)
+		if err != nil {
+			panic(errors.Wrap(err, "invalid tss public key"))
+		}
+
+		return pub
+	}).(*ecdsa.PublicKey)
+}
+
+// parsePublicKey parses the hex-encoded compressed or uncompressed secp256k1 public key.
+func parsePublicKey(raw string) (*ecdsa.PublicKey, error) {
+	decoded, err := hexutil.Decode(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode public key")
+	}
+	if len(decoded) == 33 {
+		return crypto.DecompressPubkey(decoded)
+	}
+
+	return crypto.UnmarshalPubkey(decoded)
+}
@@ -0,0 +1,41 @@
+package tss
+
+import (
+	"crypto/elliptic"
+
+	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/figure/v3"
+)
+
+const DefaultCurve = CurveSecp256k1
+
+var _ figure.Validatable = Curve("")
+
+// Curve defines the elliptic curve of the TSS key used to sign the chain withdrawals.
+type Curve string
+
+const (
+	// CurveSecp256k1 is used by the threshold ECDSA key
+	CurveSecp256k1 Curve = "secp256k1"
+	// CurveEd25519 is used by the threshold EdDSA key
+	CurveEd25519 Curve = "ed25519"
+)
+
+func (c Curve) Validate() error {
+	switch c {
+	case CurveSecp256k1, CurveEd25519:
+		return nil
+	default:
+		return errors.Errorf("invalid curve: %s", c)
+	}
+}
+
+// ellipticCurve returns the tss-lib curve, the empty value is considered secp256k1.
+func (c Curve) ellipticCurve() elliptic.Curve {
+	if c == CurveEd25519 {
+		return tss.Edwards()
+	}
+
+	return tss.S256()
+}
@@ -0,0 +1,79 @@
+package tss
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	tsscrypto "github.com/bnb-chain/tss-lib/v2/crypto"
+	"github.com/bnb-chain/tss-lib/v2/crypto/ckd"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	// depositKeyPurpose separates the deposit keys from the other possible derivations
+	depositKeyPurpose = 1
+	// depositKeyPathLength is the number of the hash-based non-hardened path indices
+	depositKeyPathLength = 4
+)
+
+// KeyDerivation is the non-hardened child key derived from the TSS public key.
+// The child key is signed by the same key shares shifted by the Delta.
+type KeyDerivation struct {
+	Delta     *big.Int
+	PublicKey *ecdsa.PublicKey
+}
+
+// DepositKeyPath returns the derivation path of the deposit key
+// of the receiver on the destination chain.
+func DepositKeyPath(chainId, receiver string) []uint32 {
+	hash := sha256.Sum256([]byte(chainId + "\x00" + receiver))
+
+	path := make([]uint32, 0, depositKeyPathLength+1)
+	path = append(path, depositKeyPurpose)
+	for i := range depositKeyPathLength {
+		path = append(path, binary.BigEndian.Uint32(hash[i*4:])&^ckd.HardenedKeyStart)
+	}
+
+	return path
+}
+
+// DeriveChildKey derives the child key of the secp256k1 TSS public key by the given path.
+// The chain code is derived from the public key itself, so the child keys
+// are the same for all the parties and do not change after the key resharing.
+func DeriveChildKey(pub *ecdsa.PublicKey, path []uint32) (*KeyDerivation, error) {
+	chainCode := sha256.Sum256(crypto.CompressPubkey(pub))
+	parent := &ckd.ExtendedKey{
+		PublicKey: *pub,
+		ChainCode: chainCode[:],
+		ParentFP:  []byte{0x00, 0x00, 0x00, 0x00},
+	}
+
+	curve := tss.S256()
+	delta, child, err := ckd.DeriveChildKeyFromHierarchy(path, parent, curve.Params().N, curve)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive child key")
+	}
+
+	return &KeyDerivation{
+		Delta:     delta,
+		PublicKey: &child.PublicKey,
+	}, nil
+}
+
+// derivedKey returns the copy of the key share adjusted to the child public key.
+// The share secret stays the same as the delta is added to it during the signing.
+func derivedKey(share keygen.LocalPartySaveData, derivation *KeyDerivation) (keygen.LocalPartySaveData, error) {
+	share.BigXj = append([]*tsscrypto.ECPoint(nil), share.BigXj...)
+	keys := []keygen.LocalPartySaveData{share}
+	if err := signing.UpdatePublicKeyAndAdjustBigXj(derivation.Delta, keys, derivation.PublicKey, tss.S256()); err != nil {
+		return share, errors.Wrap(err, "failed to adjust key share")
+	}
+
+	return keys[0], nil
+}
@@ -0,0 +1,59 @@
+package tss
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func Test_DeriveChildKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	pub := &key.PublicKey
+
+	derived, err := DeriveChildKey(pub, DepositKeyPath("1", "0x0000000000000000000000000000000000000001"))
+	if err != nil {
+		t.Fatalf("failed to derive child key: %v", err)
+	}
+
+	tcs := map[string]struct {
+		chainId  string
+		receiver string
+		equal    bool
+	}{
+		"must derive the same key for the same receiver": {
+			chainId:  "1",
+			receiver: "0x0000000000000000000000000000000000000001",
+			equal:    true,
+		},
+		"must derive another key for another receiver": {
+			chainId:  "1",
+			receiver: "0x0000000000000000000000000000000000000002",
+		},
+		"must derive another key for another destination chain": {
+			chainId:  "2",
+			receiver: "0x0000000000000000000000000000000000000001",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			other, err := DeriveChildKey(pub, DepositKeyPath(tc.chainId, tc.receiver))
+			if err != nil {
+				t.Fatalf("failed to derive child key: %v", err)
+			}
+			if equal := other.PublicKey.Equal(derived.PublicKey); equal != tc.equal {
+				t.Fatalf("expected keys equality %t, got %t", tc.equal, equal)
+			}
+		})
+	}
+
+	// the child key must be controlled by the parent secret shifted by the delta
+	childX, childY := crypto.S256().ScalarBaseMult(derived.Delta.Bytes())
+	childX, childY = crypto.S256().Add(pub.X, pub.Y, childX, childY)
+	if childX.Cmp(derived.PublicKey.X) != 0 || childY.Cmp(derived.PublicKey.Y) != 0 {
+		t.Fatal("child key does not match the parent key shifted by the delta")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p/broadcast"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	eddsaKeygen "github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
 	"github.com/bnb-chain/tss-lib/v2/tss"
 	"gitlab.com/distributed_lab/logan/v3"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -20,7 +21,12 @@ type LocalKeygenParty struct {
 	Threshold int
 }
 
-type KeygenParty struct {
+// KeygenResult is the key share produced by the keygen party.
+type KeygenResult interface {
+	keygen.LocalPartySaveData | eddsaKeygen.LocalPartySaveData
+}
+
+type KeygenParty[T KeygenResult] struct {
 	wg    *sync.WaitGroup
 	ended atomic.Bool
 
@@ -31,13 +37,38 @@ type KeygenParty struct {
 	self           LocalKeygenParty
 
 	msgs      chan partyMsg
-	result    *keygen.LocalPartySaveData
+	result    *T
 	sessionId string
 
+	curve    Curve
+	newParty func(params *tss.Parameters, out chan<- tss.Message, end chan<- *T) tss.Party
+
 	logger *logan.Entry
 }
 
-func NewKeygenParty(self LocalKeygenParty, parties []p2p.Party, sessionId string, logger *logan.Entry) *KeygenParty {
+// NewKeygenParty creates the party generating the threshold ECDSA key.
+func NewKeygenParty(self LocalKeygenParty, parties []p2p.Party, sessionId string, logger *logan.Entry) *KeygenParty[keygen.LocalPartySaveData] {
+	return newKeygenParty(self, parties, sessionId, CurveSecp256k1, logger,
+		func(params *tss.Parameters, out chan<- tss.Message, end chan<- *keygen.LocalPartySaveData) tss.Party {
+			return keygen.NewLocalParty(params, out, end, self.PreParams)
+		},
+	)
+}
+
+// NewEddsaKeygenParty creates the party generating the threshold EdDSA key.
+// Pre-parameters are not used by the EdDSA keygen.
+func NewEddsaKeygenParty(self LocalKeygenParty, parties []p2p.Party, sessionId string, logger *logan.Entry) *KeygenParty[eddsaKeygen.LocalPartySaveData] {
+	return newKeygenParty(self, parties, sessionId, CurveEd25519, logger, eddsaKeygen.NewLocalParty)
+}
+
+func newKeygenParty[T KeygenResult](
+	self LocalKeygenParty,
+	parties []p2p.Party,
+	sessionId string,
+	curve Curve,
+	logger *logan.Entry,
+	newParty func(params *tss.Parameters, out chan<- tss.Message, end chan<- *T) tss.Party,
+) *KeygenParty[T] {
 	partyMap := make(map[core.Address]struct{}, len(parties))
 	partyIds := make([]*tss.PartyID, len(parties)+1)
 	partyIds[0] = self.Address.PartyIdentifier()
@@ -47,7 +78,7 @@ func NewKeygenParty(self LocalKeygenParty, parties []p2p.Party, sessionId string
 		partyIds[i+1] = party.Identifier()
 	}
 
-	return &KeygenParty{
+	return &KeygenParty[T]{
 		broadcaster:    broadcast.NewBroadcaster(parties, logger.WithField("component", "broadcaster")),
 		sortedPartyIds: tss.SortPartyIDs(partyIds),
 		parties:        partyMap,
@@ -56,20 +87,22 @@ func NewKeygenParty(self LocalKeygenParty, parties []p2p.Party, sessionId string
 		logger:         logger,
 		sessionId:      sessionId,
 		wg:             &sync.WaitGroup{},
+		curve:          curve,
+		newParty:       newParty,
 	}
 }
 
-func (p *KeygenParty) Run(ctx context.Context) {
+func (p *KeygenParty[T]) Run(ctx context.Context) {
 	params := tss.NewParameters(
-		tss.S256(), tss.NewPeerContext(p.sortedPartyIds),
+		p.curve.ellipticCurve(), tss.NewPeerContext(p.sortedPartyIds),
 		p.sortedPartyIds.FindByKey(p.self.Address.PartyKey()),
 		len(p.sortedPartyIds),
 		p.self.Threshold,
 	)
 	out := make(chan tss.Message, OutChannelSize)
-	end := make(chan *keygen.LocalPartySaveData, EndChannelSize)
+	end := make(chan *T, EndChannelSize)
 
-	p.party = keygen.NewLocalParty(params, out, end, p.self.PreParams)
+	p.party = p.newParty(params, out, end)
 
 	p.wg.Add(3)
 
@@ -87,7 +120,7 @@ func (p *KeygenParty) Run(ctx context.Context) {
 	p.logger.Info("keygen started")
 }
 
-func (p *KeygenParty) WaitFor() *keygen.LocalPartySaveData {
+func (p *KeygenParty[T]) WaitFor() *T {
 	p.wg.Wait()
 	p.ended.Store(true)
 
@@ -96,7 +129,7 @@ func (p *KeygenParty) WaitFor() *keygen.LocalPartySaveData {
 	return p.result
 }
 
-func (p *KeygenParty) Receive(sender core.Address, data *p2p.TssData) {
+func (p *KeygenParty[T]) Receive(sender core.Address, data *p2p.TssData) {
 	if p.ended.Load() {
 		return
 	}
@@ -108,7 +141,7 @@ func (p *KeygenParty) Receive(sender core.Address, data *p2p.TssData) {
 	}
 }
 
-func (p *KeygenParty) receiveMsgs(ctx context.Context) {
+func (p *KeygenParty[T]) receiveMsgs(ctx context.Context) {
 	defer p.wg.Done()
 
 	for {
@@ -135,7 +168,7 @@ func (p *KeygenParty) receiveMsgs(ctx context.Context) {
 
 }
 
-func (p *KeygenParty) receiveUpdates(ctx context.Context, out <-chan tss.Message, end <-chan *keygen.LocalPartySaveData) {
+func (p *KeygenParty[T]) receiveUpdates(ctx context.Context, out <-chan tss.Message, end <-chan *T) {
 	defer p.wg.Done()
 
 	for {
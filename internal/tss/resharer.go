@@ -0,0 +1,327 @@
+package tss
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/core"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p/broadcast"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/resharing"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type LocalResharingParty struct {
+	Address core.Address
+	// Share is required only if the party is a member of the old committee
+	Share *keygen.LocalPartySaveData
+	// PreParams are required only if the party is a member of the new committee
+	PreParams keygen.LocalPreParams
+	// Generation of the old committee key shares, the new committee receives the next one
+	Generation uint64
+
+	OldCommittee []core.Address
+	NewCommittee []core.Address
+	OldThreshold int
+	NewThreshold int
+}
+
+func (p LocalResharingParty) IsOldCommitteeMember() bool {
+	return containsAddress(p.OldCommittee, p.Address)
+}
+
+func (p LocalResharingParty) IsNewCommitteeMember() bool {
+	return containsAddress(p.NewCommittee, p.Address)
+}
+
+type resharingMsg struct {
+	partyMsg
+	FromOldCommittee bool
+	ToOldCommittee   bool
+}
+
+// ResharingParty transfers the key shares from the old committee to the new one
+// keeping the same public key. The local party can be a member of both committees,
+// in that case it runs two tss parties with the keys of the adjacent generations.
+type ResharingParty struct {
+	wg    *sync.WaitGroup
+	ended atomic.Bool
+
+	broadcaster *broadcast.Broadcaster
+	parties     map[core.Address]struct{}
+	self        LocalResharingParty
+
+	oldPartyIds tss.SortedPartyIDs
+	newPartyIds tss.SortedPartyIDs
+	oldParty    tss.Party
+	newParty    tss.Party
+
+	msgs      chan resharingMsg
+	result    *keygen.LocalPartySaveData
+	failed    atomic.Bool
+	sessionId string
+
+	logger *logan.Entry
+}
+
+func NewResharingParty(self LocalResharingParty, parties []p2p.Party, sessionId string, logger *logan.Entry) *ResharingParty {
+	partyMap := make(map[core.Address]struct{}, len(parties))
+	for _, party := range parties {
+		partyMap[party.CoreAddress] = struct{}{}
+	}
+
+	return &ResharingParty{
+		wg:          &sync.WaitGroup{},
+		broadcaster: broadcast.NewBroadcaster(parties, logger.WithField("component", "broadcaster")),
+		parties:     partyMap,
+		self:        self,
+		oldPartyIds: committeePartyIds(self.OldCommittee, self.Generation),
+		newPartyIds: committeePartyIds(self.NewCommittee, self.Generation+1),
+		msgs:        make(chan resharingMsg, MsgsCapacity),
+		sessionId:   sessionId,
+		logger:      logger,
+	}
+}
+
+func (p *ResharingParty) Run(ctx context.Context) {
+	oldCtx, newCtx := tss.NewPeerContext(p.oldPartyIds), tss.NewPeerContext(p.newPartyIds)
+	newParams := func(partyId *tss.PartyID) *tss.ReSharingParameters {
+		return tss.NewReSharingParameters(
+			tss.S256(), oldCtx, newCtx, partyId,
+			len(p.oldPartyIds), p.self.OldThreshold,
+			len(p.newPartyIds), p.self.NewThreshold,
+		)
+	}
+
+	out := make(chan tss.Message, OutChannelSize)
+	var oldEnd, newEnd chan *keygen.LocalPartySaveData
+
+	if p.self.IsOldCommitteeMember() {
+		oldEnd = make(chan *keygen.LocalPartySaveData, EndChannelSize)
+		partyId := p.oldPartyIds.FindByKey(p.self.Address.PartyKeyAt(p.self.Generation))
+		// the old committee share secret is erased by the end of the resharing,
+		// so the copy is used to keep the local share valid until it is replaced
+		share := *p.self.Share
+		share.Xi = new(big.Int).Set(share.Xi)
+		p.oldParty = resharing.NewLocalParty(newParams(partyId), share, out, oldEnd)
+	}
+	if p.self.IsNewCommitteeMember() {
+		newEnd = make(chan *keygen.LocalPartySaveData, EndChannelSize)
+		partyId := p.newPartyIds.FindByKey(p.self.Address.PartyKeyAt(p.self.Generation + 1))
+		save := keygen.NewLocalPartySaveData(len(p.newPartyIds))
+		save.LocalPreParams = p.self.PreParams
+		p.newParty = resharing.NewLocalParty(newParams(partyId), save, out, newEnd)
+	}
+
+	p.wg.Add(2)
+	for _, pair := range []struct {
+		party tss.Party
+		end   chan *keygen.LocalPartySaveData
+	}{{p.oldParty, oldEnd}, {p.newParty, newEnd}} {
+		if pair.party == nil {
+			continue
+		}
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+
+			if err := pair.party.Start(); err != nil {
+				p.logger.WithError(err).Error("failed to run resharing")
+				close(pair.end)
+			}
+		}()
+	}
+	go p.receiveMsgs(ctx)
+	go p.receiveUpdates(ctx, out, oldEnd, newEnd)
+
+	p.logger.Info("resharing started")
+}
+
+// WaitFor returns the key share of the new committee member,
+// the result is nil if the local party is a member of the old committee only.
+func (p *ResharingParty) WaitFor() (*keygen.LocalPartySaveData, error) {
+	p.wg.Wait()
+	p.ended.Store(true)
+
+	p.logger.Info("resharing finished")
+
+	if p.failed.Load() {
+		return nil, errors.New("resharing failed")
+	}
+
+	return p.result, nil
+}
+
+func (p *ResharingParty) Receive(sender core.Address, data *p2p.TssData) {
+	if p.ended.Load() {
+		return
+	}
+
+	p.msgs <- resharingMsg{
+		partyMsg: partyMsg{
+			Sender:      sender,
+			WireMsg:     data.Data,
+			IsBroadcast: data.IsBroadcast,
+		},
+		FromOldCommittee: data.FromOldCommittee,
+		ToOldCommittee:   data.ToOldCommittee,
+	}
+}
+
+func (p *ResharingParty) receiveMsgs(ctx context.Context) {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.logger.Warn("context is done; stopping receiving messages")
+			return
+		case msg, ok := <-p.msgs:
+			if !ok {
+				return
+			}
+
+			if _, exists := p.parties[msg.Sender]; !exists && msg.Sender != p.self.Address {
+				p.logger.WithField("party", msg.Sender).Warn("got message from outside party")
+				continue
+			}
+
+			party := p.newParty
+			if msg.ToOldCommittee {
+				party = p.oldParty
+			}
+			if party == nil {
+				p.logger.WithField("party", msg.Sender).Warn("got message for the committee the local party is not a member of")
+				continue
+			}
+
+			sender := p.newPartyIds.FindByKey(msg.Sender.PartyKeyAt(p.self.Generation + 1))
+			if msg.FromOldCommittee {
+				sender = p.oldPartyIds.FindByKey(msg.Sender.PartyKeyAt(p.self.Generation))
+			}
+			if sender == nil {
+				p.logger.WithField("party", msg.Sender).Warn("got message from the party outside the committee")
+				continue
+			}
+
+			if _, err := party.UpdateFromBytes(msg.WireMsg, sender, msg.IsBroadcast); err != nil {
+				p.logger.WithError(err).Error("failed to update party state")
+			}
+		}
+	}
+}
+
+func (p *ResharingParty) receiveUpdates(
+	ctx context.Context,
+	out <-chan tss.Message,
+	oldEnd, newEnd <-chan *keygen.LocalPartySaveData,
+) {
+	defer p.wg.Done()
+
+	for {
+		// both committees are finished
+		if oldEnd == nil && newEnd == nil {
+			close(p.msgs)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			p.logger.Warn("context is done; stopping listening to updates")
+			p.failed.Store(true)
+			return
+		case _, ok := <-oldEnd:
+			if !ok {
+				p.logger.Error("old committee party result channel is closed")
+				p.failed.Store(true)
+			}
+			oldEnd = nil
+		case result, ok := <-newEnd:
+			if !ok {
+				p.logger.Error("new committee party result channel is closed")
+				p.failed.Store(true)
+			}
+			p.result = result
+			newEnd = nil
+		case msg := <-out:
+			p.send(msg)
+		}
+	}
+}
+
+func (p *ResharingParty) send(msg tss.Message) {
+	raw, routing, err := msg.WireBytes()
+	if err != nil {
+		p.logger.WithError(err).Error("failed to get message wire bytes")
+		return
+	}
+
+	fromOldCommittee := core.KeyGeneration(routing.From.KeyInt()) == p.self.Generation
+
+	// committee roles are defined per receiver, so the message is sent to each of them separately
+	for _, dst := range routing.To {
+		if dst.KeyInt().Cmp(routing.From.KeyInt()) == 0 {
+			continue
+		}
+
+		tssData := &p2p.TssData{
+			Data:             raw,
+			IsBroadcast:      routing.IsBroadcast,
+			FromOldCommittee: fromOldCommittee,
+			ToOldCommittee:   core.KeyGeneration(dst.KeyInt()) == p.self.Generation,
+		}
+
+		receiver := core.AddrFromPartyId(dst)
+		if receiver == p.self.Address {
+			// the message between the committee roles of the local party
+			p.msgs <- resharingMsg{
+				partyMsg: partyMsg{
+					Sender:      p.self.Address,
+					WireMsg:     tssData.Data,
+					IsBroadcast: tssData.IsBroadcast,
+				},
+				FromOldCommittee: tssData.FromOldCommittee,
+				ToOldCommittee:   tssData.ToOldCommittee,
+			}
+			continue
+		}
+
+		tssReq, _ := anypb.New(tssData)
+		submitReq := p2p.SubmitRequest{
+			Sender:    p.self.Address.String(),
+			SessionId: p.sessionId,
+			Type:      p2p.RequestType_RT_RESHARE,
+			Data:      tssReq,
+		}
+
+		if err = p.broadcaster.Send(&submitReq, receiver); err != nil {
+			p.logger.WithError(err).WithField("party", receiver).Error("failed to send message")
+		}
+	}
+}
+
+func committeePartyIds(committee []core.Address, generation uint64) tss.SortedPartyIDs {
+	partyIds := make([]*tss.PartyID, len(committee))
+	for i, member := range committee {
+		partyIds[i] = member.PartyIdentifierAt(generation)
+	}
+
+	return tss.SortPartyIDs(partyIds)
+}
+
+func containsAddress(addresses []core.Address, addr core.Address) bool {
+	for _, a := range addresses {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
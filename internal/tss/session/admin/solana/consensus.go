@@ -0,0 +1,69 @@
+package solana
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/consensus"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ consensus.SigningData            = SigningData{}
+	_ consensus.Mechanism[SigningData] = &ConsensusMechanism{}
+)
+
+type SigningData struct {
+	Operation solana.AdminOperation
+	// SigData is the authority message hash, empty if the operation is not authorized by the bridge authority
+	SigData []byte
+}
+
+func (s SigningData) HashString() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+type ConsensusMechanism struct {
+	operation solana.AdminOperation
+	client    *solana.Client
+}
+
+func NewConsensusMechanism(operation solana.AdminOperation, client *solana.Client) *ConsensusMechanism {
+	return &ConsensusMechanism{
+		operation: operation,
+		client:    client,
+	}
+}
+
+func (c ConsensusMechanism) FormProposalData() (*SigningData, error) {
+	sigData, err := c.client.GetAdminSignHash(c.operation)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get admin sign hash")
+	}
+
+	return &SigningData{
+		Operation: c.operation,
+		SigData:   sigData,
+	}, nil
+}
+
+func (c ConsensusMechanism) VerifyProposedData(data SigningData) error {
+	expected, err := c.FormProposalData()
+	if err != nil {
+		return errors.Wrap(err, "failed to form local proposal data")
+	}
+
+	// the operation parameters and the sign data must match the locally formed ones
+	if expected.HashString() != data.HashString() {
+		return errors.New(fmt.Sprintf("proposed operation %s does not match the local one %s", data.Operation, expected.Operation))
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package solana
+
+import (
+	"context"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+// Finalizer submits the administration instruction from the relayer fee payer,
+// only the session leader submits it.
+type Finalizer struct {
+	data      *SigningData
+	signature *common.SignatureData
+
+	client  *solana.Client
+	relayer *solana.Relayer
+
+	sessionLeader bool
+
+	logger *logan.Entry
+}
+
+func NewFinalizer(client *solana.Client, relayer *solana.Relayer, logger *logan.Entry, sessionLeader bool) *Finalizer {
+	return &Finalizer{
+		client:        client,
+		relayer:       relayer,
+		logger:        logger,
+		sessionLeader: sessionLeader,
+	}
+}
+
+func (f *Finalizer) WithData(data *SigningData) *Finalizer {
+	f.data = data
+	return f
+}
+
+func (f *Finalizer) WithSignature(signature *common.SignatureData) *Finalizer {
+	f.signature = signature
+	return f
+}
+
+// Finalize returns the submitted transaction signature, empty for the non-leader parties.
+func (f *Finalizer) Finalize(ctx context.Context) (string, error) {
+	if !f.sessionLeader {
+		return "", nil
+	}
+	if f.relayer == nil {
+		return "", errors.New("relayer key is required to submit the instruction")
+	}
+
+	f.logger.Info("finalization started")
+
+	var signature []byte
+	if f.signature != nil {
+		signature = append(f.signature.Signature, f.signature.SignatureRecovery...)
+	}
+
+	instruction, err := f.client.AdminInstruction(f.data.Operation, signature, f.relayer.Address())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build admin instruction")
+	}
+
+	txSig, err := f.relayer.Send(ctx, instruction)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to send admin transaction")
+	}
+
+	f.logger.Info("finalization finished")
+
+	return txSig.String(), nil
+}
@@ -3,7 +3,11 @@ package session
 import "time"
 
 const (
-	BoundaryKeygenSession = time.Minute
+	BoundaryKeygenSession       = time.Minute
+	BoundaryKeyResharingSession = 2 * time.Minute
+
+	BoundaryShareRefresh             = BoundaryKeyResharingSession + 2*BoundaryShareRefreshConfirmation
+	BoundaryShareRefreshConfirmation = 10 * time.Second
 
 	BoundarySigningSession = BoundaryConsensus + BoundarySign + BoundarySignatureDistribution + BoundaryFinalize
 
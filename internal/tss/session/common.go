@@ -13,14 +13,35 @@ const (
 	KeygenSessionPrefix  = "KEYGEN"
 	SignSessionPrefix    = "SIGN"
 	ReshareSessionPrefix = "RESHARE"
+	RefreshSessionPrefix = "REFRESH"
+	AdminSessionPrefix   = "ADMIN"
 )
 
+// MaxSigningBatchSize is the maximum number of deposits signed within one signing session
+// for the chains supporting batching, the chain batch size is limited by it.
+const MaxSigningBatchSize = 5
+
+// SweepingSessionInterval defines how often the Bitcoin signing session is replaced
+// with the deposit addresses sweeping one. Must be the same for all the parties.
+const SweepingSessionInterval = 10
+
 type Params struct {
 	Id        int64     `fig:"session_id,required"`
 	StartTime time.Time `fig:"start_time,required"`
 	Threshold int       `fig:"threshold,required"`
 }
 
+// Pause defines the time ranges the signing sessions must not run within,
+// leaving them for the other sessions run by the parties (f.e. key share refresh).
+type Pause interface {
+	Overlaps(from, to time.Time) bool
+}
+
+// Paused reports whether the signing session starting at the given time must be skipped.
+func Paused(pause Pause, startTime time.Time) bool {
+	return pause != nil && pause.Overlaps(startTime, startTime.Add(BoundarySigningSession))
+}
+
 type SigningParams struct {
 	Params
 	ChainId string
@@ -73,6 +94,14 @@ func GetReshareSessionIdentifier(sessionId int64) string {
 	return fmt.Sprintf("%s_%d", ReshareSessionPrefix, sessionId)
 }
 
+func GetRefreshSessionIdentifier(sessionId int64) string {
+	return fmt.Sprintf("%s_%d", RefreshSessionPrefix, sessionId)
+}
+
+func GetAdminSessionIdentifier(sessionId int64) string {
+	return fmt.Sprintf("%s_%d", AdminSessionPrefix, sessionId)
+}
+
 func GetDefaultSigningSessionIdentifier(sessionId int64) string {
 	return fmt.Sprintf("%s_%d", SignSessionPrefix, sessionId)
 }
@@ -0,0 +1,249 @@
+package distributor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	utxoclient "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
+	"github.com/Bridgeless-Project/tss-svc/internal/core"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p/broadcast"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const DepositAddressAcceptorSessionIdentifier = "DEPOSIT_ADDRESS_DISTRIBUTION"
+
+var _ p2p.TssSession = &DepositAddressDistributionSession{}
+
+type DistributedDepositAddressMsg struct {
+	Distributor core.Address
+	Data        *p2p.DepositAddressDistributionData
+}
+
+// DepositAddressDistributionSession shares the deposit addresses requested from the local API
+// with the other parties. Each party derives the received address itself and starts watching it,
+// so the deposits to the address can be verified and swept by all the parties.
+type DepositAddressDistributionSession struct {
+	clients chain.Repository
+	data    db.DepositAddressesQ
+	logger  *logan.Entry
+
+	distributors map[core.Address]struct{}
+	broadcaster  *broadcast.Broadcaster
+	self         core.Address
+
+	msgs chan DistributedDepositAddressMsg
+}
+
+func NewDepositAddressDistributionSession(
+	self core.Address,
+	distributors []p2p.Party,
+	clients chain.Repository,
+	data db.DepositAddressesQ,
+	logger *logan.Entry,
+) *DepositAddressDistributionSession {
+	distributorsMap := make(map[core.Address]struct{}, len(distributors))
+	for _, distributor := range distributors {
+		distributorsMap[distributor.CoreAddress] = struct{}{}
+	}
+
+	return &DepositAddressDistributionSession{
+		clients:      clients,
+		msgs:         make(chan DistributedDepositAddressMsg, 100),
+		data:         data,
+		logger:       logger,
+		self:         self,
+		broadcaster:  broadcast.NewBroadcaster(distributors, logger.WithField("component", "broadcaster")),
+		distributors: distributorsMap,
+	}
+}
+
+func (d *DepositAddressDistributionSession) Run(ctx context.Context) {
+	wg := sync.WaitGroup{}
+
+	wg.Add(2)
+	go func() {
+		d.runDistributor(ctx)
+		wg.Done()
+	}()
+	go func() {
+		d.runAcceptor(ctx)
+		wg.Done()
+	}()
+
+	wg.Wait()
+}
+
+func (d *DepositAddressDistributionSession) runDistributor(ctx context.Context) {
+	d.logger.Info("distributor started")
+
+	cooldown := time.Second * 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("distributor cancelled")
+			return
+		case <-time.After(cooldown):
+			cooldown = time.Second * 5
+
+			address, err := d.data.GetNotDistributed()
+			if err != nil {
+				d.logger.WithError(err).Error("failed to get not distributed deposit address")
+				continue
+			} else if address == nil {
+				continue
+			}
+
+			// the local API only stores the address, so it is watched here as well
+			if err = d.watch(address.ChainId, address.Address); err != nil {
+				d.logger.WithField("address", address.Address).WithError(err).Error("failed to watch deposit address")
+				continue
+			}
+
+			raw, _ := anypb.New(&p2p.DepositAddressDistributionData{
+				ChainId:            address.ChainId,
+				DestinationChainId: address.DestinationChainId,
+				Receiver:           address.DestinationAddress,
+			})
+			d.broadcaster.Broadcast(&p2p.SubmitRequest{
+				Sender:    d.self.String(),
+				Type:      p2p.RequestType_RT_DEPOSIT_ADDRESS_DISTRIBUTION,
+				SessionId: DepositAddressAcceptorSessionIdentifier,
+				Data:      raw,
+			})
+
+			if err = d.data.UpdateDistributedStatus(address.ChainId, address.Address, true); err != nil {
+				d.logger.
+					WithField("address", address.Address).
+					WithError(err).
+					Error("failed to update deposit address as distributed")
+				continue
+			}
+
+			cooldown = time.Second * 0
+		}
+	}
+}
+
+func (d *DepositAddressDistributionSession) runAcceptor(ctx context.Context) {
+	d.logger.Info("acceptor started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("acceptor cancelled")
+			return
+		case msg := <-d.msgs:
+			d.logger.Info(fmt.Sprintf("received deposit address from %s", msg.Distributor))
+
+			if err := d.accept(msg.Data); err != nil {
+				d.logger.WithError(err).Error("failed to accept deposit address")
+				continue
+			}
+
+			d.logger.Info("deposit address successfully accepted")
+		}
+	}
+}
+
+func (d *DepositAddressDistributionSession) accept(data *p2p.DepositAddressDistributionData) error {
+	client, err := d.utxoClient(data.ChainId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain client")
+	}
+	dstClient, err := d.clients.Client(data.DestinationChainId)
+	if err != nil {
+		return errors.Wrap(err, "unsupported destination chain")
+	}
+	if !dstClient.AddressValid(data.Receiver) {
+		return errors.New("invalid receiver address")
+	}
+
+	address, err := client.DepositAddress(data.DestinationChainId, data.Receiver)
+	if err != nil {
+		return errors.Wrap(err, "failed to derive deposit address")
+	}
+	if err = client.WatchAddress(address); err != nil {
+		return errors.Wrap(err, "failed to watch deposit address")
+	}
+
+	err = d.data.Insert(db.DepositAddress{
+		ChainId:            data.ChainId,
+		Address:            address,
+		DestinationChainId: data.DestinationChainId,
+		DestinationAddress: data.Receiver,
+		Distributed:        true,
+	})
+
+	return errors.Wrap(err, "failed to save deposit address")
+}
+
+func (d *DepositAddressDistributionSession) watch(chainId, address string) error {
+	client, err := d.utxoClient(chainId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get chain client")
+	}
+
+	return client.WatchAddress(address)
+}
+
+func (d *DepositAddressDistributionSession) utxoClient(chainId string) (utxoclient.Client, error) {
+	client, err := d.clients.Client(chainId)
+	if err != nil {
+		return nil, errors.Wrap(err, "unsupported chain")
+	}
+	if client.Type() != chain.TypeBitcoin {
+		return nil, errors.New("deposit addresses are not supported for the chain")
+	}
+
+	return chain.Unwrap(client).(utxoclient.Client), nil
+}
+
+func (d *DepositAddressDistributionSession) Id() string {
+	return DepositAddressAcceptorSessionIdentifier
+}
+
+func (d *DepositAddressDistributionSession) Receive(request *p2p.SubmitRequest) error {
+	if request == nil || request.Data == nil {
+		return errors.New("nil request")
+	}
+	if request.Type != p2p.RequestType_RT_DEPOSIT_ADDRESS_DISTRIBUTION {
+		return errors.New("invalid request type")
+	}
+	sender, err := core.AddressFromString(request.Sender)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse sender address")
+	}
+
+	if _, ok := d.distributors[sender]; !ok {
+		return errors.New(fmt.Sprintf("sender '%s' is not a valid deposit address distributor", sender))
+	}
+
+	data := &p2p.DepositAddressDistributionData{}
+	if err = request.Data.UnmarshalTo(data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal deposit address data")
+	}
+
+	d.msgs <- DistributedDepositAddressMsg{
+		Distributor: sender,
+		Data:        data,
+	}
+
+	return nil
+}
+
+// RegisterIdChangeListener is a no-op for DepositAddressDistributionSession
+func (d *DepositAddressDistributionSession) RegisterIdChangeListener(func(oldId string, newId string)) {
+}
+
+// SigningSessionInfo is a no-op for DepositAddressDistributionSession
+func (d *DepositAddressDistributionSession) SigningSessionInfo() *p2p.SigningSessionInfo {
+	return nil
+}
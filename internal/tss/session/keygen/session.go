@@ -11,13 +11,17 @@ import (
 	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	eddsaKeygen "github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/logan/v3"
 )
 
-var _ p2p.TssSession = &Session{}
+var (
+	_ p2p.TssSession = &Session[keygen.LocalPartySaveData]{}
+	_ p2p.TssSession = &Session[eddsaKeygen.LocalPartySaveData]{}
+)
 
-type Session struct {
+type Session[T tss.KeygenResult] struct {
 	sessionId string
 	params    session.Params
 	wg        *sync.WaitGroup
@@ -27,11 +31,11 @@ type Session struct {
 
 	keygenParty interface {
 		Run(ctx context.Context)
-		WaitFor() *keygen.LocalPartySaveData
+		WaitFor() *T
 		Receive(sender core.Address, data *p2p.TssData)
 	}
 
-	result *keygen.LocalPartySaveData
+	result *T
 	err    error
 
 	logger *logan.Entry
@@ -43,9 +47,9 @@ func NewSession(
 	params session.Params,
 	connectedPartiesCountFunc func() int,
 	logger *logan.Entry,
-) *Session {
+) *Session[keygen.LocalPartySaveData] {
 	sessionId := session.GetKeygenSessionIdentifier(params.Id)
-	return &Session{
+	return &Session[keygen.LocalPartySaveData]{
 		sessionId:             sessionId,
 		params:                params,
 		wg:                    &sync.WaitGroup{},
@@ -56,7 +60,27 @@ func NewSession(
 	}
 }
 
-func (s *Session) Run(ctx context.Context) error {
+// NewEddsaSession creates the session generating the threshold EdDSA key.
+func NewEddsaSession(
+	self tss.LocalKeygenParty,
+	parties []p2p.Party,
+	params session.Params,
+	connectedPartiesCountFunc func() int,
+	logger *logan.Entry,
+) *Session[eddsaKeygen.LocalPartySaveData] {
+	sessionId := session.GetKeygenSessionIdentifier(params.Id)
+	return &Session[eddsaKeygen.LocalPartySaveData]{
+		sessionId:             sessionId,
+		params:                params,
+		wg:                    &sync.WaitGroup{},
+		connectedPartiesCount: connectedPartiesCountFunc,
+		partiesCount:          len(parties),
+		keygenParty:           tss.NewEddsaKeygenParty(self, parties, sessionId, logger.WithField("component", "keygen_party")),
+		logger:                logger,
+	}
+}
+
+func (s *Session[T]) Run(ctx context.Context) error {
 	runDelay := time.Until(s.params.StartTime)
 	if runDelay <= 0 {
 		return errors.New("target time is in the past")
@@ -82,7 +106,7 @@ func (s *Session) Run(ctx context.Context) error {
 	return nil
 }
 
-func (s *Session) run(ctx context.Context) {
+func (s *Session[T]) run(ctx context.Context) {
 	defer s.wg.Done()
 
 	boundedCtx, cancel := context.WithTimeout(ctx, session.BoundaryKeygenSession)
@@ -102,16 +126,16 @@ func (s *Session) run(ctx context.Context) {
 	}
 }
 
-func (s *Session) WaitFor() (*keygen.LocalPartySaveData, error) {
+func (s *Session[T]) WaitFor() (*T, error) {
 	s.wg.Wait()
 	return s.result, s.err
 }
 
-func (s *Session) Id() string {
+func (s *Session[T]) Id() string {
 	return s.sessionId
 }
 
-func (s *Session) Receive(request *p2p.SubmitRequest) error {
+func (s *Session[T]) Receive(request *p2p.SubmitRequest) error {
 	if request == nil || request.Data == nil {
 		return errors.New("nil request")
 	}
@@ -139,9 +163,9 @@ func (s *Session) Receive(request *p2p.SubmitRequest) error {
 }
 
 // RegisterIdChangeListener is a no-op for Session
-func (s *Session) RegisterIdChangeListener(func(oldId, newId string)) {}
+func (s *Session[T]) RegisterIdChangeListener(func(oldId, newId string)) {}
 
 // SigningSessionInfo is a no-op for Session
-func (s *Session) SigningSessionInfo() *p2p.SigningSessionInfo {
+func (s *Session[T]) SigningSessionInfo() *p2p.SigningSessionInfo {
 	return nil
 }
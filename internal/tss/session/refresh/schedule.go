@@ -0,0 +1,64 @@
+package refresh
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
+	"github.com/pkg/errors"
+)
+
+// MinInterval leaves at least one signing session between the key share refreshes.
+const MinInterval = session.BoundaryShareRefresh + 2*session.BoundarySigningSession
+
+type Settings struct {
+	Enabled  bool          `fig:"enabled"`
+	Interval time.Duration `fig:"interval"`
+}
+
+func (s Settings) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+	if s.Interval < MinInterval {
+		return errors.New(fmt.Sprintf("interval must be at least %s", MinInterval))
+	}
+
+	return nil
+}
+
+var _ session.Pause = Schedule{}
+
+// Schedule defines the key share refreshes starting every interval after the start time.
+// Each refresh reserves the session.BoundaryShareRefresh window the signing sessions must not run within.
+type Schedule struct {
+	StartTime time.Time
+	Interval  time.Duration
+}
+
+// Start returns the start time of the refresh with the given identifier.
+func (s Schedule) Start(id int64) time.Time {
+	return s.StartTime.Add(time.Duration(id) * s.Interval)
+}
+
+// Next returns the identifier of the first refresh starting after the given time.
+func (s Schedule) Next(after time.Time) int64 {
+	if after.Before(s.StartTime) {
+		return 1
+	}
+
+	return int64(after.Sub(s.StartTime)/s.Interval) + 1
+}
+
+func (s Schedule) Overlaps(from, to time.Time) bool {
+	// windows are shorter than the interval, so only the latest two may overlap the range
+	latest := s.Next(to) - 1
+	for id := latest; id >= 1 && id >= latest-1; id-- {
+		start := s.Start(id)
+		if start.Before(to) && start.Add(session.BoundaryShareRefresh).After(from) {
+			return true
+		}
+	}
+
+	return false
+}
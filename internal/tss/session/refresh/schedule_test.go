@@ -0,0 +1,66 @@
+package refresh
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
+)
+
+func Test_ScheduleOverlaps(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := Schedule{StartTime: start, Interval: time.Hour}
+	signingSession := func(from time.Time) (time.Time, time.Time) {
+		return from, from.Add(session.BoundarySigningSession)
+	}
+
+	tcs := map[string]struct {
+		from     time.Time
+		overlaps bool
+	}{
+		"must not pause before the first refresh": {
+			from: start,
+		},
+		"must not pause the session ending at the refresh start": {
+			from: start.Add(time.Hour - session.BoundarySigningSession),
+		},
+		"must pause the session running at the refresh start": {
+			from:     start.Add(time.Hour - time.Second),
+			overlaps: true,
+		},
+		"must pause the session starting within the refresh": {
+			from:     start.Add(time.Hour + session.BoundaryShareRefresh - time.Second),
+			overlaps: true,
+		},
+		"must not pause the session starting after the refresh": {
+			from: start.Add(time.Hour + session.BoundaryShareRefresh),
+		},
+		"must pause the session within the later refresh": {
+			from:     start.Add(5*time.Hour + time.Minute),
+			overlaps: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			if overlaps := schedule.Overlaps(signingSession(tc.from)); overlaps != tc.overlaps {
+				t.Fatalf("expected overlaps %t, got %t", tc.overlaps, overlaps)
+			}
+		})
+	}
+}
+
+func Test_ScheduleNext(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := Schedule{StartTime: start, Interval: time.Hour}
+
+	if next := schedule.Next(start.Add(-time.Minute)); next != 1 {
+		t.Fatalf("expected the first refresh, got %d", next)
+	}
+	if next := schedule.Next(start.Add(time.Hour)); next != 2 {
+		t.Fatalf("expected the second refresh, got %d", next)
+	}
+	if !schedule.Start(schedule.Next(start.Add(90 * time.Minute))).Equal(start.Add(2 * time.Hour)) {
+		t.Fatal("unexpected next refresh start time")
+	}
+}
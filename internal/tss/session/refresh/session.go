@@ -0,0 +1,295 @@
+package refresh
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/core"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p/broadcast"
+	"github.com/Bridgeless-Project/tss-svc/internal/secrets"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var _ p2p.TssSession = &Session{}
+
+// Session periodically rerandomizes the ECDSA key shares of all the parties
+// without changing the public key, so the shares leaked before the refresh become useless.
+// The refreshed share is confirmed in two rounds: the parties accept the refresh result first
+// and commit to it once all the acceptances are received. The refreshed share replaces the local one
+// only after all the parties committed, the replaced share is kept in the secrets storage.
+type Session struct {
+	sessionId        *atomic.String
+	idChangeListener func(oldId string, newId string)
+	mu               *sync.RWMutex
+
+	self      core.Address
+	share     *keygen.LocalPartySaveData
+	threshold int
+
+	parties     []p2p.Party
+	committee   []core.Address
+	broadcaster *broadcast.Broadcaster
+
+	schedule Schedule
+	storage  secrets.Storage
+
+	resharingParty *tss.ResharingParty
+	acceptances    chan core.Address
+	commits        chan core.Address
+
+	logger *logan.Entry
+}
+
+// NewSession creates the key share refresh session.
+// The share is replaced in place, so it must be shared with the signing sessions
+// paused by the schedule to see the refreshed one.
+func NewSession(
+	self core.Address,
+	share *keygen.LocalPartySaveData,
+	threshold int,
+	parties []p2p.Party,
+	schedule Schedule,
+	storage secrets.Storage,
+	logger *logan.Entry,
+) *Session {
+	committee := make([]core.Address, 0, len(parties)+1)
+	committee = append(committee, self)
+	for _, party := range parties {
+		committee = append(committee, party.CoreAddress)
+	}
+
+	return &Session{
+		sessionId:   atomic.NewString(session.GetRefreshSessionIdentifier(schedule.Next(time.Now()))),
+		mu:          &sync.RWMutex{},
+		self:        self,
+		share:       share,
+		threshold:   threshold,
+		parties:     parties,
+		committee:   committee,
+		broadcaster: broadcast.NewBroadcaster(parties, logger.WithField("component", "broadcaster")),
+		schedule:    schedule,
+		storage:     storage,
+		logger:      logger,
+	}
+}
+
+func (s *Session) Run(ctx context.Context) error {
+	for {
+		id := s.schedule.Next(time.Now())
+		startTime := s.schedule.Start(id)
+
+		// the pre-parameters are generated anew for every refresh, so the refreshed shares do not reuse them
+		preParams, err := s.generatePreParams(ctx, startTime)
+		if err != nil {
+			if ctx.Err() != nil {
+				s.logger.Info("key share refresh session cancelled")
+				return nil
+			}
+
+			s.logger.WithError(err).Error(fmt.Sprintf("failed to generate pre-parameters, skipping key share refresh %d", id))
+			select {
+			case <-ctx.Done():
+				s.logger.Info("key share refresh session cancelled")
+				return nil
+			case <-time.After(time.Until(startTime)):
+			}
+			continue
+		}
+		s.prepare(id, *preParams)
+
+		s.logger.Info(fmt.Sprintf("waiting for next key share refresh %s to start in %s", s.Id(), time.Until(startTime)))
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("key share refresh session cancelled")
+			return nil
+		case <-time.After(time.Until(startTime)):
+		}
+
+		s.logger.Info(fmt.Sprintf("key share refresh %s started", s.Id()))
+		if err := s.runRefresh(ctx); err != nil {
+			s.logger.WithError(err).Error("failed to refresh key share, keeping the current one")
+		}
+		s.logger.Info(fmt.Sprintf("key share refresh %s finished", s.Id()))
+	}
+}
+
+func (s *Session) generatePreParams(ctx context.Context, deadline time.Time) (*keygen.LocalPreParams, error) {
+	genCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	preParams, err := keygen.GeneratePreParamsWithContext(genCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate pre-parameters")
+	}
+	if !preParams.ValidateWithProof() {
+		return nil, errors.New("generated pre-parameters are invalid")
+	}
+
+	return preParams, nil
+}
+
+func (s *Session) prepare(id int64, preParams keygen.LocalPreParams) {
+	prevSessionId, nextSessionId := s.Id(), session.GetRefreshSessionIdentifier(id)
+	resharingParty := tss.NewResharingParty(
+		tss.LocalResharingParty{
+			Address:      s.self,
+			Share:        s.share,
+			PreParams:    preParams,
+			Generation:   core.KeyGeneration(s.share.ShareID),
+			OldCommittee: s.committee,
+			NewCommittee: s.committee,
+			OldThreshold: s.threshold,
+			NewThreshold: s.threshold,
+		},
+		s.parties,
+		nextSessionId,
+		s.logger.WithField("component", "resharing_party"),
+	)
+
+	s.mu.Lock()
+	s.resharingParty = resharingParty
+	s.acceptances = make(chan core.Address, len(s.parties))
+	s.commits = make(chan core.Address, len(s.parties))
+	s.sessionId.Store(nextSessionId)
+	s.mu.Unlock()
+
+	if s.idChangeListener != nil && prevSessionId != nextSessionId {
+		s.idChangeListener(prevSessionId, nextSessionId)
+	}
+}
+
+func (s *Session) runRefresh(ctx context.Context) error {
+	resharingCtx, cancel := context.WithTimeout(ctx, session.BoundaryKeyResharingSession)
+	defer cancel()
+
+	s.resharingParty.Run(resharingCtx)
+	result, err := s.resharingParty.WaitFor()
+	if err != nil {
+		return errors.Wrap(err, "failed to reshare key")
+	}
+	if result == nil || !result.ECDSAPub.Equals(s.share.ECDSAPub) {
+		return errors.New("refreshed key share does not match the current public key")
+	}
+
+	if err = s.confirm(ctx, p2p.RequestType_RT_ACCEPTANCE, s.acceptances); err != nil {
+		return errors.Wrap(err, "failed to accept key share refresh")
+	}
+	// every party committed has received all the acceptances, so the refresh succeeded for everyone;
+	// if the local party misses some commit, the parties that received all of them switch to the refreshed share
+	// and have to restore the previous one (see `helpers vault set restore-tss-share`)
+	if err = s.confirm(ctx, p2p.RequestType_RT_SHARE_COMMIT, s.commits); err != nil {
+		return errors.Wrap(err, "failed to commit key share refresh")
+	}
+
+	if err = s.storage.SavePreviousTssShare(s.share); err != nil {
+		return errors.Wrap(err, "failed to save previous key share")
+	}
+	if err = s.storage.SaveTssShare(result); err != nil {
+		return errors.Wrap(err, "failed to save refreshed key share")
+	}
+	// signing sessions are paused during the refresh, so the share is not in use
+	*s.share = *result
+
+	s.logger.Info(fmt.Sprintf("key share refreshed to generation %d", core.KeyGeneration(result.ShareID)))
+
+	return nil
+}
+
+// confirm broadcasts the local confirmation of the given type and waits for the ones of all the parties.
+func (s *Session) confirm(ctx context.Context, confirmationType p2p.RequestType, confirmations <-chan core.Address) error {
+	dataRaw, _ := anypb.New(&p2p.AcceptanceData{Accepted: true})
+	s.broadcaster.Broadcast(&p2p.SubmitRequest{
+		Sender:    s.self.String(),
+		SessionId: s.Id(),
+		Type:      confirmationType,
+		Data:      dataRaw,
+	})
+
+	confirmationCtx, cancel := context.WithTimeout(ctx, session.BoundaryShareRefreshConfirmation)
+	defer cancel()
+
+	confirmed := make(map[core.Address]struct{}, len(s.parties))
+	for len(confirmed) != len(s.parties) {
+		select {
+		case <-confirmationCtx.Done():
+			return errors.New(fmt.Sprintf("only %d of %d parties confirmed", len(confirmed), len(s.parties)))
+		case sender := <-confirmations:
+			confirmed[sender] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (s *Session) Id() string {
+	return s.sessionId.Load()
+}
+
+func (s *Session) Receive(request *p2p.SubmitRequest) error {
+	if request == nil || request.Data == nil {
+		return errors.New("nil request")
+	}
+	if request.SessionId != s.Id() {
+		return errors.New(fmt.Sprintf("session id mismatch: expected '%s', got '%s'", s.Id(), request.SessionId))
+	}
+
+	sender, err := core.AddressFromString(request.Sender)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse sender address")
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	switch request.Type {
+	case p2p.RequestType_RT_RESHARE:
+		data := &p2p.TssData{}
+		if err = request.Data.UnmarshalTo(data); err != nil {
+			return errors.Wrap(err, "failed to unmarshal TSS request data")
+		}
+
+		s.resharingParty.Receive(sender, data)
+	case p2p.RequestType_RT_ACCEPTANCE, p2p.RequestType_RT_SHARE_COMMIT:
+		data := &p2p.AcceptanceData{}
+		if err = request.Data.UnmarshalTo(data); err != nil {
+			return errors.Wrap(err, "failed to unmarshal acceptance data")
+		}
+		if !data.Accepted {
+			return nil
+		}
+
+		confirmations := s.acceptances
+		if request.Type == p2p.RequestType_RT_SHARE_COMMIT {
+			confirmations = s.commits
+		}
+
+		select {
+		case confirmations <- sender:
+		default:
+			return errors.New("too many confirmations")
+		}
+	default:
+		return errors.New("invalid request type")
+	}
+
+	return nil
+}
+
+func (s *Session) RegisterIdChangeListener(f func(oldId, newId string)) {
+	s.idChangeListener = f
+}
+
+// SigningSessionInfo is a no-op for Session
+func (s *Session) SigningSessionInfo() *p2p.SigningSessionInfo {
+	return nil
+}
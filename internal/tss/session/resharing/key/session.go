@@ -0,0 +1,146 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/core"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
+	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+var _ p2p.TssSession = &Session{}
+
+// Session reshares the ECDSA key from the old committee to the new one
+// without changing the public key.
+type Session struct {
+	sessionId string
+	params    session.Params
+	wg        *sync.WaitGroup
+
+	connectedPartiesCount func() int
+	partiesCount          int
+
+	resharingParty *tss.ResharingParty
+
+	result *keygen.LocalPartySaveData
+	err    error
+
+	logger *logan.Entry
+}
+
+// NewSession creates the key resharing session.
+// The parties must include all the members of both committees except the local one.
+func NewSession(
+	self tss.LocalResharingParty,
+	parties []p2p.Party,
+	params session.Params,
+	connectedPartiesCountFunc func() int,
+	logger *logan.Entry,
+) *Session {
+	sessionId := session.GetReshareSessionIdentifier(params.Id)
+	return &Session{
+		sessionId:             sessionId,
+		params:                params,
+		wg:                    &sync.WaitGroup{},
+		connectedPartiesCount: connectedPartiesCountFunc,
+		partiesCount:          len(parties),
+		resharingParty:        tss.NewResharingParty(self, parties, sessionId, logger.WithField("component", "resharing_party")),
+		logger:                logger,
+	}
+}
+
+func (s *Session) Run(ctx context.Context) error {
+	runDelay := time.Until(s.params.StartTime)
+	if runDelay <= 0 {
+		return errors.New("target time is in the past")
+	}
+
+	s.logger.Info(fmt.Sprintf("key resharing session will start in %s", runDelay))
+
+	select {
+	case <-ctx.Done():
+		s.logger.Info("key resharing session cancelled")
+		return nil
+	case <-time.After(runDelay):
+		if s.connectedPartiesCount() != s.partiesCount {
+			return errors.New("cannot start key resharing session: not all parties connected")
+		}
+	}
+
+	s.logger.Info("key resharing session started")
+
+	s.wg.Add(1)
+	go s.run(ctx)
+
+	return nil
+}
+
+func (s *Session) run(ctx context.Context) {
+	defer s.wg.Done()
+
+	boundedCtx, cancel := context.WithTimeout(ctx, session.BoundaryKeyResharingSession)
+	defer cancel()
+
+	s.resharingParty.Run(boundedCtx)
+	s.result, s.err = s.resharingParty.WaitFor()
+	s.logger.Info("key resharing session finished")
+	if s.err == nil {
+		return
+	}
+
+	if err := boundedCtx.Err(); err != nil {
+		s.err = err
+	}
+}
+
+// WaitFor returns the new key share of the local party,
+// the result is nil if the local party is not a member of the new committee.
+func (s *Session) WaitFor() (*keygen.LocalPartySaveData, error) {
+	s.wg.Wait()
+	return s.result, s.err
+}
+
+func (s *Session) Id() string {
+	return s.sessionId
+}
+
+func (s *Session) Receive(request *p2p.SubmitRequest) error {
+	if request == nil || request.Data == nil {
+		return errors.New("nil request")
+	}
+	if request.Type != p2p.RequestType_RT_RESHARE {
+		return errors.New("invalid request type")
+	}
+	if request.SessionId != s.sessionId {
+		return errors.New(fmt.Sprintf("session id mismatch: expected '%s', got '%s'", s.sessionId, request.SessionId))
+	}
+
+	data := &p2p.TssData{}
+	if err := request.Data.UnmarshalTo(data); err != nil {
+		return errors.Wrap(err, "failed to unmarshal TSS request data")
+	}
+
+	sender, err := core.AddressFromString(request.Sender)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse sender address")
+	}
+
+	s.resharingParty.Receive(sender, data)
+
+	return nil
+}
+
+// RegisterIdChangeListener is a no-op for Session
+func (s *Session) RegisterIdChangeListener(func(oldId, newId string)) {}
+
+// SigningSessionInfo is a no-op for Session
+func (s *Session) SigningSessionInfo() *p2p.SigningSessionInfo {
+	return nil
+}
@@ -0,0 +1,55 @@
+package rotation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/consensus"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ consensus.SigningData            = SigningData{}
+	_ consensus.Mechanism[SigningData] = &ConsensusMechanism{}
+)
+
+type SigningData struct {
+	Rotation chain.SignerRotation
+}
+
+func (s SigningData) HashString() string {
+	data, err := json.Marshal(s.Rotation)
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+type ConsensusMechanism struct {
+	rotator chain.SignerRotator
+}
+
+func NewConsensusMechanism(rotator chain.SignerRotator) *ConsensusMechanism {
+	return &ConsensusMechanism{rotator: rotator}
+}
+
+func (c ConsensusMechanism) FormProposalData() (*SigningData, error) {
+	rotation, err := c.rotator.FormRotation(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to form signer rotation")
+	}
+
+	return &SigningData{Rotation: *rotation}, nil
+}
+
+func (c ConsensusMechanism) VerifyProposedData(data SigningData) error {
+	if len(data.Rotation.SigData) == 0 {
+		return errors.New("no data to sign provided")
+	}
+
+	return errors.Wrap(c.rotator.VerifyRotation(context.Background(), data.Rotation), "invalid signer rotation")
+}
@@ -0,0 +1,66 @@
+package rotation
+
+import (
+	"context"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+// Finalizer submits the signed rotation and verifies the new key is set on-chain,
+// only the session leader submits it.
+type Finalizer struct {
+	rotator chain.SignerRotator
+
+	data          *SigningData
+	signatures    []*common.SignatureData
+	sessionLeader bool
+
+	logger *logan.Entry
+}
+
+func NewFinalizer(rotator chain.SignerRotator, logger *logan.Entry, sessionLeader bool) *Finalizer {
+	return &Finalizer{
+		rotator:       rotator,
+		logger:        logger,
+		sessionLeader: sessionLeader,
+	}
+}
+
+func (f *Finalizer) WithData(data *SigningData) *Finalizer {
+	f.data = data
+	return f
+}
+
+func (f *Finalizer) WithSignatures(signatures []*common.SignatureData) *Finalizer {
+	f.signatures = signatures
+	return f
+}
+
+// Finalize returns the submitted transactions reference, empty for the non-leader parties.
+func (f *Finalizer) Finalize(ctx context.Context) (string, error) {
+	if !f.sessionLeader {
+		return "", nil
+	}
+
+	f.logger.Info("finalization started")
+
+	signatures := make([][]byte, len(f.signatures))
+	for i, signature := range f.signatures {
+		signatures[i] = append(signature.Signature, signature.SignatureRecovery...)
+	}
+
+	result, err := f.rotator.SubmitRotation(ctx, f.data.Rotation, signatures)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to submit signer rotation")
+	}
+	if err = f.rotator.VerifyRotated(ctx); err != nil {
+		return result, errors.Wrap(err, "failed to verify new signer")
+	}
+
+	f.logger.Info("finalization finished")
+
+	return result, nil
+}
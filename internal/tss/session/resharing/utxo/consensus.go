@@ -73,7 +73,7 @@ func (m *ConsensusMechanism) FormProposalData() (*SigningData, error) {
 		return nil, errors.New("not enough unspent outputs to consolidate")
 	}
 
-	tx, sigHashes, err := m.consolidateOutputs(unspent)
+	tx, sigHashes, prevScripts, err := m.consolidateOutputs(unspent)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to consolidate outputs")
 	}
@@ -87,11 +87,12 @@ func (m *ConsensusMechanism) FormProposalData() (*SigningData, error) {
 		ProposalData: &p2p.BitcoinResharingProposalData{
 			SerializedTx: buf.Bytes(),
 			SigData:      sigHashes,
+			PrevScripts:  prevScripts,
 		},
 	}, nil
 }
 
-func (m *ConsensusMechanism) consolidateOutputs(unspent []btcjson.ListUnspentResult) (*wire.MsgTx, [][]byte, error) {
+func (m *ConsensusMechanism) consolidateOutputs(unspent []btcjson.ListUnspentResult) (*wire.MsgTx, [][]byte, [][]byte, error) {
 	arranged := m.helper.ArrangeOutputs(unspent)
 	receiverScript, _ := m.helper.PayToAddrScript(m.dstAddr)
 
@@ -101,22 +102,28 @@ func (m *ConsensusMechanism) consolidateOutputs(unspent []btcjson.ListUnspentRes
 	}
 
 	totalAmount := int64(0)
+	prevScripts := make([][]byte, len(arranged))
 	for i := range len(arranged) {
 		hash, err := chainhash.NewHashFromStr(unspent[i].TxID)
 		if err != nil {
-			return nil, nil, errors.Wrap(err, fmt.Sprintf("failed to parse tx hash for input %d", i))
+			return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("failed to parse tx hash for input %d", i))
 		}
 
 		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, unspent[i].Vout), nil, nil))
 		totalAmount += utxoutils.ToUnits(unspent[i].Amount)
+
+		prevScripts[i], err = hex.DecodeString(unspent[i].ScriptPubKey)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("failed to decode script for input %d", i))
+		}
 	}
 
-	fees := m.helper.EstimateFee(tx, btcutil.Amount(m.params.FeeRate))
+	fees := m.helper.EstimateFee(tx, prevScripts, btcutil.Amount(m.params.FeeRate))
 	consolidationAmount := totalAmount - int64(fees)
 	amountPerOutput := consolidationAmount / int64(m.params.OutputsCount)
 
 	if !m.client.WithdrawalAmountValid(big.NewInt(amountPerOutput)) {
-		return nil, nil, errors.New("amount per output is too small")
+		return nil, nil, nil, errors.New("amount per output is too small")
 	}
 
 	for _, out := range tx.TxOut {
@@ -129,19 +136,15 @@ func (m *ConsensusMechanism) consolidateOutputs(unspent []btcjson.ListUnspentRes
 	for i := range tx.TxIn {
 		utxo := arranged[i]
 
-		scriptDecoded, err := hex.DecodeString(utxo.ScriptPubKey)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, fmt.Sprintf("failed to decode script for input %d", i))
-		}
-		sigHash, err := m.helper.CalculateSignatureHash(scriptDecoded, tx, i, utxoutils.ToUnits(utxo.Amount))
+		sigHash, err := m.helper.CalculateSignatureHash(prevScripts[i], tx, i, utxoutils.ToUnits(utxo.Amount))
 		if err != nil {
-			return nil, nil, errors.Wrap(err, fmt.Sprintf("failed to calculate signature hash for input %d", i))
+			return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("failed to calculate signature hash for input %d", i))
 		}
 
 		sigHashes[i] = sigHash
 	}
 
-	return tx, sigHashes, nil
+	return tx, sigHashes, prevScripts, nil
 }
 
 func (m *ConsensusMechanism) VerifyProposedData(data SigningData) error {
@@ -163,7 +166,7 @@ func (m *ConsensusMechanism) VerifyProposedData(data SigningData) error {
 		return errors.Wrap(err, "failed to find used inputs in the transaction")
 	}
 
-	originalTx, sigHashes, err := m.consolidateOutputs(used)
+	originalTx, sigHashes, prevScripts, err := m.consolidateOutputs(used)
 	if err != nil {
 		return errors.Wrap(err, "failed to consolidate outputs from used inputs")
 	}
@@ -183,6 +186,14 @@ func (m *ConsensusMechanism) VerifyProposedData(data SigningData) error {
 			return errors.Errorf("signature hash mismatch at index %d", i)
 		}
 	}
+	if len(prevScripts) != len(data.ProposalData.PrevScripts) {
+		return errors.New("previous scripts number mismatch")
+	}
+	for i := range data.ProposalData.PrevScripts {
+		if !bytes.Equal(data.ProposalData.PrevScripts[i], prevScripts[i]) {
+			return errors.Errorf("previous script mismatch at index %d", i)
+		}
+	}
 
 	return nil
 }
@@ -74,7 +74,7 @@ func (f *Finalizer) finalize() {
 		f.errChan <- errors.Wrap(err, "failed to deserialize transaction")
 		return
 	}
-	if err := f.client.UtxoHelper().InjectSignatures(&tx, f.signatures, f.tssPub); err != nil {
+	if err := f.client.UtxoHelper().InjectSignatures(&tx, f.data.ProposalData.PrevScripts, f.signatures, f.tssPub); err != nil {
 		f.errChan <- errors.Wrap(err, "failed to inject signatures")
 		return
 	}
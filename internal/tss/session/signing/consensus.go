@@ -1,6 +1,8 @@
 package signing
 
 import (
+	"fmt"
+
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/deposit"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
 	"github.com/Bridgeless-Project/tss-svc/internal/db"
@@ -11,11 +13,14 @@ import (
 
 var _ consensus.Mechanism[withdrawal.DepositSigningData] = &ConsensusMechanism[withdrawal.DepositSigningData]{}
 
+// ConsensusMechanism proposes and verifies signing data for a batch of pending deposits.
+// The batch size is bounded by the chain-specific limit.
 type ConsensusMechanism[T withdrawal.DepositSigningData] struct {
 	depositSelector db.DepositsSelector
 	depositsQ       db.DepositsQ
 	constructor     withdrawal.Constructor[T]
 	fetcher         deposit.Fetcher
+	batchSize       uint64
 }
 
 func NewConsensusMechanism[T withdrawal.DepositSigningData](
@@ -23,31 +28,37 @@ func NewConsensusMechanism[T withdrawal.DepositSigningData](
 	depositsQ db.DepositsQ,
 	constructor withdrawal.Constructor[T],
 	fetcher *deposit.Fetcher,
+	batchSize uint64,
 ) *ConsensusMechanism[T] {
+	if batchSize == 0 {
+		batchSize = 1
+	}
+
 	var pendingWithdrawalStatus = types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING
 	return &ConsensusMechanism[T]{
 		depositSelector: db.DepositsSelector{
 			WithdrawalChainId: &chainId,
 			Status:            &pendingWithdrawalStatus,
 			Distributed:       true, // only consider deposits that have been distributed to other parties
-			One:               true,
+			Limit:             batchSize,
 		},
 		depositsQ:   depositsQ,
 		constructor: constructor,
 		fetcher:     *fetcher,
+		batchSize:   batchSize,
 	}
 }
 
 func (c *ConsensusMechanism[T]) FormProposalData() (*T, error) {
-	unsignedDeposit, err := c.depositsQ.GetWithSelector(c.depositSelector)
+	unsignedDeposits, err := c.depositsQ.Select(c.depositSelector)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get deposit")
+		return nil, errors.Wrap(err, "failed to get deposits")
 	}
-	if unsignedDeposit == nil {
+	if len(unsignedDeposits) == 0 {
 		return nil, nil
 	}
 
-	proposalData, err := c.constructor.FormSigningData(*unsignedDeposit)
+	proposalData, err := c.constructor.FormSigningData(unsignedDeposits)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to form proposal data")
 	}
@@ -56,33 +67,60 @@ func (c *ConsensusMechanism[T]) FormProposalData() (*T, error) {
 }
 
 func (c *ConsensusMechanism[T]) VerifyProposedData(data T) error {
-	unsignedDeposit, err := c.depositsQ.Get(data.DepositIdentifier())
+	identifiers := data.DepositIdentifiers()
+	if len(identifiers) == 0 {
+		return errors.New("no deposits proposed")
+	}
+	if uint64(len(identifiers)) > c.batchSize {
+		return errors.New(fmt.Sprintf("proposed deposits count %d exceeds the batch size %d", len(identifiers), c.batchSize))
+	}
+
+	unsignedDeposits := make([]db.Deposit, len(identifiers))
+	seen := make(map[db.DepositIdentifier]struct{}, len(identifiers))
+	for i, identifier := range identifiers {
+		if _, exists := seen[identifier]; exists {
+			return errors.New(fmt.Sprintf("duplicated deposit %s proposed", identifier))
+		}
+		seen[identifier] = struct{}{}
+
+		unsignedDeposit, err := c.getPendingDeposit(identifier)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid deposit %s", identifier))
+		}
+		unsignedDeposits[i] = *unsignedDeposit
+	}
+
+	isValid, err := c.constructor.IsValid(data, unsignedDeposits)
 	if err != nil {
-		return errors.Wrap(err, "failed to get deposit")
+		return errors.Wrap(err, "failed to validate proposal data")
+	}
+	if !isValid {
+		return errors.New("proposal data is invalid")
+	}
+
+	return nil
+}
+
+func (c *ConsensusMechanism[T]) getPendingDeposit(identifier db.DepositIdentifier) (*db.Deposit, error) {
+	unsignedDeposit, err := c.depositsQ.Get(identifier)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get deposit")
 	}
 	if unsignedDeposit == nil {
-		unsignedDeposit, err = c.fetcher.FetchDeposit(data.DepositIdentifier())
+		unsignedDeposit, err = c.fetcher.FetchDeposit(identifier)
 		if err != nil {
-			return errors.Wrap(err, "failed to fetch deposit")
+			return nil, errors.Wrap(err, "failed to fetch deposit")
 		}
 		unsignedDeposit.Distributed = true
 		if _, err := c.depositsQ.Insert(*unsignedDeposit); err != nil {
 			if !errors.Is(err, db.ErrAlreadySubmitted) {
-				return errors.Wrap(err, "failed to save fetched deposit")
+				return nil, errors.Wrap(err, "failed to save fetched deposit")
 			}
 		}
 	}
 	if unsignedDeposit.WithdrawalStatus != types.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING {
-		return errors.New("deposit is not in pending status")
+		return nil, errors.New("deposit is not in pending status")
 	}
 
-	isValid, err := c.constructor.IsValid(data, *unsignedDeposit)
-	if err != nil {
-		return errors.Wrap(err, "failed to validate proposal data")
-	}
-	if !isValid {
-		return errors.New("proposal data is invalid")
-	}
-
-	return nil
+	return unsignedDeposit, nil
 }
@@ -0,0 +1,24 @@
+package cosmos
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/cosmos"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
+	"github.com/pkg/errors"
+)
+
+// NewConstructor creates the withdrawal constructor ensuring the configured bridge address
+// is the TSS account one, as the deposits are sent to and the withdrawals are sent from it.
+func NewConstructor(client *cosmos.Client, tssPub *ecdsa.PublicKey) (*withdrawal.CosmosWithdrawalConstructor, error) {
+	tssAddress, err := client.Address(tssPub)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tss account address")
+	}
+	if tssAddress != client.Chain().BridgeAddress {
+		return nil, errors.New(fmt.Sprintf("bridge address does not match the tss account address %s", tssAddress))
+	}
+
+	return withdrawal.NewCosmosConstructor(client, tssPub), nil
+}
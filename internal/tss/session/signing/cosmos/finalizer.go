@@ -0,0 +1,65 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/cosmos"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
+	database "github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+var _ signing.Finalizer[withdrawal.CosmosWithdrawalData] = &Finalizer{}
+
+type Finalizer struct {
+	db     database.DepositsQ
+	client *cosmos.Client
+
+	sessionLeader bool
+}
+
+func NewFinalizerFactory(db database.DepositsQ, client *cosmos.Client) signing.FinalizerFactory[withdrawal.CosmosWithdrawalData] {
+	return func(sessionLeader bool, _ *logan.Entry) signing.Finalizer[withdrawal.CosmosWithdrawalData] {
+		return &Finalizer{
+			db:            db,
+			client:        client,
+			sessionLeader: sessionLeader,
+		}
+	}
+}
+
+func (f *Finalizer) Finalize(_ context.Context, data *withdrawal.CosmosWithdrawalData, signatures []*common.SignatureData) error {
+	if len(signatures) != 1 {
+		return errors.New("exactly one signature expected")
+	}
+
+	signedTx, withdrawalTxHash, err := cosmos.EncodeTransaction(data.UnsignedTransaction(), signatures[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to encode signed transaction")
+	}
+	encodedTx := base64.StdEncoding.EncodeToString(signedTx)
+
+	for _, identifier := range data.DepositIdentifiers() {
+		if err = f.db.UpdateProcessed(database.ProcessedDepositData{
+			Identifier: identifier,
+			TxData:     &encodedTx,
+			TxHash:     &withdrawalTxHash,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update signature")
+		}
+	}
+
+	if !f.sessionLeader {
+		return nil
+	}
+
+	if err = f.client.SendSignedTransaction(signedTx); err != nil {
+		return errors.Wrap(err, "failed to send signed transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package signing
+
+import (
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	"github.com/pkg/errors"
+)
+
+// UpdateDepositsStatus sets the status for all the deposits included in the signing session.
+func UpdateDepositsStatus(depositsQ db.DepositsQ, identifiers []db.DepositIdentifier, status types.WithdrawalStatus) error {
+	for _, identifier := range identifiers {
+		if err := depositsQ.UpdateStatus(identifier, status); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to update status for deposit %s", identifier))
+		}
+	}
+
+	return nil
+}
@@ -2,7 +2,6 @@ package signing
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -22,7 +21,7 @@ type SignaturesDistributor struct {
 	distributor core.Address
 	self        core.Address
 	sigData     [][]byte
-	sigPubKey   *ecdsa.PublicKey
+	signer      tss.LocalSignParty
 
 	broadcaster *broadcast.ReliableBroadcaster[tss.Signatures]
 
@@ -47,7 +46,7 @@ func NewSignaturesDistributor(
 		sessionId:   sessionId,
 		distributor: distributor,
 		self:        self.Account.CosmosAddress(),
-		sigPubKey:   self.Share.ECDSAPub.ToECDSAPubKey(),
+		signer:      self,
 
 		broadcaster: broadcast.NewReliable[tss.Signatures](
 			sessionId,
@@ -75,6 +74,12 @@ func (s *SignaturesDistributor) WithSigData(sigData [][]byte) *SignaturesDistrib
 	return s
 }
 
+// WithSigner replaces the party the distributed signatures are verified against.
+func (s *SignaturesDistributor) WithSigner(signer tss.LocalSignParty) *SignaturesDistributor {
+	s.signer = signer
+	return s
+}
+
 func (s *SignaturesDistributor) Run(ctx context.Context) {
 	s.wg.Add(1)
 
@@ -138,7 +143,7 @@ func (s *SignaturesDistributor) validateSignatures() error {
 	}
 
 	for i, signature := range s.signatures.Data {
-		if !tss.Verify(s.sigPubKey, s.sigData[i], signature) {
+		if !s.signer.VerifySignature(s.sigData[i], signature) {
 			return errors.New("got invalid signature")
 		}
 	}
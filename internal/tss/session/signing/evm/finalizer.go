@@ -2,80 +2,109 @@ package evm
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/evm"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
-	coreConnector "github.com/Bridgeless-Project/tss-svc/internal/core/connector"
 	database "github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/logan/v3"
 )
 
+var _ signing.Finalizer[withdrawal.EvmWithdrawalData] = &Finalizer{}
+
 type Finalizer struct {
-	withdrawalData *withdrawal.EvmWithdrawalData
-	signature      *common.SignatureData
+	db database.DepositsQ
 
-	db   database.DepositsQ
-	core *coreConnector.Connector
+	// relayer is optional, if set, the session leader sends the signed withdrawal to the bridge contract
+	relayer *evm.Relayer
 
 	sessionLeader bool
 
-	errChan chan error
-
 	logger *logan.Entry
 }
 
-func NewFinalizer(
-	db database.DepositsQ,
-	core *coreConnector.Connector,
-	logger *logan.Entry,
-	sessionLeader bool) *Finalizer {
-	return &Finalizer{
-		db:            db,
-		core:          core,
-		errChan:       make(chan error),
-		logger:        logger,
-		sessionLeader: sessionLeader,
+// NewFinalizerFactory creates the finalizers of the chains running the EVM-compatible bridge contract,
+// the relayer is optional.
+func NewFinalizerFactory(db database.DepositsQ, relayer *evm.Relayer) signing.FinalizerFactory[withdrawal.EvmWithdrawalData] {
+	return func(sessionLeader bool, logger *logan.Entry) signing.Finalizer[withdrawal.EvmWithdrawalData] {
+		return &Finalizer{
+			db:            db,
+			relayer:       relayer,
+			sessionLeader: sessionLeader,
+			logger:        logger,
+		}
 	}
 }
 
-func (ef *Finalizer) WithData(withdrawalData *withdrawal.EvmWithdrawalData) *Finalizer {
-	ef.withdrawalData = withdrawalData
-	return ef
-}
-
-func (ef *Finalizer) WithSignature(sig *common.SignatureData) *Finalizer {
-	ef.signature = sig
-	return ef
-}
+func (ef *Finalizer) Finalize(ctx context.Context, data *withdrawal.EvmWithdrawalData, sigs []*common.SignatureData) error {
+	identifiers := data.DepositIdentifiers()
+	if len(identifiers) != len(sigs) {
+		return errors.New("signatures count does not match deposits count")
+	}
 
-func (ef *Finalizer) Finalize(ctx context.Context) error {
-	ef.logger.Info("finalization started")
-	go ef.finalize(ctx)
+	signatures := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		signatures[i] = convertToEthSignature(sigs[i])
+		if err := ef.db.UpdateProcessed(database.ProcessedDepositData{
+			Identifier: identifier,
+			Signature:  &signatures[i],
+		}); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to update signature for deposit %s", identifier))
+		}
+	}
 
-	// listen for ctx and errors
-	select {
-	case <-ctx.Done():
-		return errors.Wrap(ctx.Err(), "finalization timed out")
-	case err := <-ef.errChan:
-		ef.logger.Info("finalization finished")
+	if !ef.sessionLeader || ef.relayer == nil {
+		return nil
+	}
 
-		return errors.Wrap(err, "failed to finalize withdrawal")
+	// relaying failure does not invalidate the signed withdrawal,
+	// it still can be claimed by the user manually
+	for i, identifier := range identifiers {
+		if err := ef.relay(ctx, identifier, signatures[i]); err != nil {
+			ef.logger.WithError(err).Errorf("failed to relay withdrawal for deposit %s", identifier)
+		}
 	}
+
+	return nil
 }
 
-func (ef *Finalizer) finalize(_ context.Context) {
-	signature := convertToEthSignature(ef.signature)
-	if err := ef.db.UpdateProcessed(database.ProcessedDepositData{
-		Identifier: ef.withdrawalData.DepositIdentifier(),
-		Signature:  &signature,
-	}); err != nil {
-		ef.errChan <- errors.Wrap(err, "failed to update signature")
-		return
+func (ef *Finalizer) relay(ctx context.Context, identifier database.DepositIdentifier, signature string) error {
+	deposit, err := ef.db.Get(identifier)
+	if err != nil {
+		return errors.Wrap(err, "failed to get deposit")
+	}
+	if deposit == nil {
+		return errors.New("deposit not found")
+	}
+
+	tx, err := ef.relayer.Relay(ctx, *deposit, hexutil.MustDecode(signature))
+	if err != nil {
+		return errors.Wrap(err, "failed to send withdrawal transaction")
 	}
 
-	ef.errChan <- nil
+	ef.logger.Infof("withdrawal transaction %s sent", tx.Hash().Hex())
+
+	// receipt tracking outlives the finalization phase deadline
+	go func() {
+		if _, err := ef.relayer.WaitForReceipt(context.Background(), tx); err != nil {
+			ef.logger.WithError(err).Errorf("withdrawal transaction %s was not executed", tx.Hash().Hex())
+			return
+		}
+
+		txHash := tx.Hash().Hex()
+		if err := ef.db.UpdateWithdrawalTxHash(identifier, txHash); err != nil {
+			ef.logger.WithError(err).Error("failed to update withdrawal details")
+			return
+		}
+
+		ef.logger.Infof("withdrawal transaction %s executed", txHash)
+	}()
+
+	return nil
 }
 
 func convertToEthSignature(sig *common.SignatureData) string {
@@ -0,0 +1,76 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/plugin"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
+	database "github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
+	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+var _ signing.Finalizer[withdrawal.PluginWithdrawalData] = &Finalizer{}
+
+type Finalizer struct {
+	db     database.DepositsQ
+	client *plugin.Client
+
+	sessionLeader bool
+}
+
+func NewFinalizerFactory(db database.DepositsQ, client *plugin.Client) signing.FinalizerFactory[withdrawal.PluginWithdrawalData] {
+	return func(sessionLeader bool, _ *logan.Entry) signing.Finalizer[withdrawal.PluginWithdrawalData] {
+		return &Finalizer{
+			db:            db,
+			client:        client,
+			sessionLeader: sessionLeader,
+		}
+	}
+}
+
+func (f *Finalizer) Finalize(_ context.Context, data *withdrawal.PluginWithdrawalData, signatures []*common.SignatureData) error {
+	if len(signatures) != len(data.ProposalData.SigHashes) {
+		return errors.New("signatures count does not match sig hashes count")
+	}
+
+	// the session leader adapter is expected to submit the withdrawal
+	processed, err := f.client.Finalize(data.SigningData(), signatures, f.sessionLeader)
+	if err != nil {
+		return errors.Wrap(err, "failed to finalize withdrawal by adapter")
+	}
+
+	signed := make(map[database.DepositIdentifier]struct{})
+	for _, identifier := range data.DepositIdentifiers() {
+		signed[identifier] = struct{}{}
+	}
+
+	for _, deposit := range processed {
+		if deposit == nil {
+			continue
+		}
+
+		identifier := database.DepositIdentifier{
+			ChainId: deposit.Identifier.GetChainId(),
+			TxHash:  deposit.Identifier.GetTxHash(),
+			TxNonce: deposit.Identifier.GetTxNonce(),
+		}
+		if _, ok := signed[identifier]; !ok {
+			return errors.New(fmt.Sprintf("adapter returned unexpected deposit %s", identifier))
+		}
+
+		if err = f.db.UpdateProcessed(database.ProcessedDepositData{
+			Identifier: identifier,
+			Signature:  deposit.Signature,
+			TxHash:     deposit.WithdrawalTxHash,
+			TxData:     deposit.TxData,
+		}); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to update processed deposit %s", identifier))
+		}
+	}
+
+	return nil
+}
@@ -1,4 +1,4 @@
-package ton
+package signing
 
 import (
 	"context"
@@ -6,18 +6,14 @@ import (
 	"sync"
 	"time"
 
-	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/ton"
-
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/deposit"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
 	"github.com/Bridgeless-Project/tss-svc/internal/core"
-	"github.com/Bridgeless-Project/tss-svc/internal/core/connector"
 	"github.com/Bridgeless-Project/tss-svc/internal/db"
 	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss/session"
 	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/consensus"
-	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
 	"github.com/Bridgeless-Project/tss-svc/internal/types"
 	"github.com/bnb-chain/tss-lib/v2/common"
 	tsslib "github.com/bnb-chain/tss-lib/v2/tss"
@@ -26,9 +22,28 @@ import (
 	"go.uber.org/atomic"
 )
 
-var _ p2p.TssSession = &Session{}
+// SessionData is the deposit signing data processed by the generic signing session.
+type SessionData interface {
+	withdrawal.DepositSigningData
+	// SigData returns the data to be signed in the order the finalizer expects the signatures.
+	SigData() [][]byte
+}
+
+// Finalizer completes the withdrawal of the signed session data,
+// f.e. stores the signatures and submits the signed transaction.
+type Finalizer[T SessionData] interface {
+	Finalize(ctx context.Context, data *T, signatures []*common.SignatureData) error
+}
 
-type Session struct {
+// FinalizerFactory creates the finalizer of a single session run,
+// sessionLeader is set if the local party leads the session.
+type FinalizerFactory[T SessionData] func(sessionLeader bool, logger *logan.Entry) Finalizer[T]
+
+var _ p2p.TssSession = &Session[withdrawal.EvmWithdrawalData]{}
+
+// Session periodically signs the pending withdrawals of the chain.
+// The chain-specific logic is provided by the withdrawal constructor and the finalizer.
+type Session[T SessionData] struct {
 	sessionId            *atomic.String
 	sessionLeader        core.Address
 	idChangeListener     func(oldId string, newId string)
@@ -41,30 +56,32 @@ type Session struct {
 	self   tss.LocalSignParty
 	db     db.DepositsQ
 	params session.SigningParams
+	pause  session.Pause
 	logger *logan.Entry
 
-	coreConnector *connector.Connector
-	fetcher       *deposit.Fetcher
-	client        *ton.Client
+	fetcher      *deposit.Fetcher
+	constructor  withdrawal.Constructor[T]
+	batchSize    uint64
+	newFinalizer FinalizerFactory[T]
 
-	mechanism consensus.Mechanism[withdrawal.TonWithdrawalData]
+	mechanism consensus.Mechanism[T]
 
-	signingParty          *tss.SignParty
-	consensusParty        *consensus.Consensus[withdrawal.TonWithdrawalData]
-	signaturesDistributor *signing.SignaturesDistributor
-	finalizer             *Finalizer
+	signingParty          *tss.BatchSignParty
+	consensusParty        *consensus.Consensus[T]
+	signaturesDistributor *SignaturesDistributor
+	finalizer             Finalizer[T]
 }
 
-func NewSession(
+func NewSession[T SessionData](
 	self tss.LocalSignParty,
 	parties []p2p.Party,
 	params session.SigningParams,
 	db db.DepositsQ,
 	logger *logan.Entry,
-) *Session {
+) *Session[T] {
 	sessionId := session.GetConcreteSigningSessionIdentifier(params.ChainId, params.Id)
 
-	return &Session{
+	return &Session[T]{
 		sessionId:            atomic.NewString(sessionId),
 		mu:                   &sync.RWMutex{},
 		nextSessionStartTime: params.StartTime,
@@ -79,44 +96,57 @@ func NewSession(
 	}
 }
 
-func (s *Session) WithDepositFetcher(fetcher *deposit.Fetcher) *Session {
+func (s *Session[T]) WithDepositFetcher(fetcher *deposit.Fetcher) *Session[T] {
 	s.fetcher = fetcher
 	return s
 }
 
-func (s *Session) WithClient(client *ton.Client) *Session {
-	s.client = client
+// WithConstructor sets the chain withdrawal constructor,
+// batchSize limits the number of deposits withdrawn within one session.
+func (s *Session[T]) WithConstructor(constructor withdrawal.Constructor[T], batchSize uint64) *Session[T] {
+	s.constructor = constructor
+	s.batchSize = batchSize
 	return s
 }
 
-func (s *Session) WithCoreConnector(conn *connector.Connector) *Session {
-	s.coreConnector = conn
+func (s *Session[T]) WithFinalizer(newFinalizer FinalizerFactory[T]) *Session[T] {
+	s.newFinalizer = newFinalizer
+	return s
+}
+
+// WithPause skips the signing sessions overlapping the pause. Optional.
+func (s *Session[T]) WithPause(pause session.Pause) *Session[T] {
+	s.pause = pause
 	return s
 }
 
 // Build is a method that should be called before Run to prepare the session for execution.
-func (s *Session) Build() error {
+func (s *Session[T]) Build() error {
 	if s.fetcher == nil {
 		return errors.New("deposit fetcher is not set")
 	}
-	if s.client == nil {
-		return errors.New("blockchain client is not set")
+	if s.constructor == nil {
+		return errors.New("withdrawal constructor is not set")
+	}
+	if s.batchSize == 0 || s.batchSize > session.MaxSigningBatchSize {
+		return errors.New(fmt.Sprintf("batch size %d is out of range [1, %d]", s.batchSize, session.MaxSigningBatchSize))
 	}
-	if s.coreConnector == nil {
-		return errors.New("core connector is not set")
+	if s.newFinalizer == nil {
+		return errors.New("finalizer is not set")
 	}
 
-	s.mechanism = signing.NewConsensusMechanism[withdrawal.TonWithdrawalData](
+	s.mechanism = NewConsensusMechanism[T](
 		s.params.ChainId,
 		s.db,
-		withdrawal.NewTonConstructor(s.client),
+		s.constructor,
 		s.fetcher,
+		s.batchSize,
 	)
 
 	return nil
 }
 
-func (s *Session) Run(ctx context.Context) error {
+func (s *Session[T]) Run(ctx context.Context) error {
 	if time.Until(s.nextSessionStartTime) <= 0 {
 		return errors.New("target time is in the past")
 	}
@@ -125,7 +155,7 @@ func (s *Session) Run(ctx context.Context) error {
 		s.mu.Lock()
 		s.logger = s.logger.WithField("session_id", s.Id())
 		s.sessionLeader = session.DetermineLeader(s.Id(), s.sortedPartyIds)
-		s.consensusParty = consensus.New[withdrawal.TonWithdrawalData](
+		s.consensusParty = consensus.New[T](
 			consensus.LocalConsensusParty{
 				SessionId: s.Id(),
 				Threshold: s.self.Threshold,
@@ -136,24 +166,24 @@ func (s *Session) Run(ctx context.Context) error {
 			s.mechanism,
 			s.logger.WithField("phase", "consensus"),
 		)
-		s.signingParty = tss.NewSignParty(s.self, s.Id(), s.logger.WithField("phase", "signing"))
-		s.signaturesDistributor = signing.NewSignaturesDistributor(
+		// a batch of one data is signed with the same messages as the single sign party sends
+		s.signingParty = tss.NewBatchSignParty(s.self, s.Id(), int(s.batchSize), s.logger.WithField("phase", "signing"))
+		s.signaturesDistributor = NewSignaturesDistributor(
 			s.Id(),
 			s.parties,
 			s.self,
 			s.sessionLeader,
 			s.logger.WithField("phase", "signatures_distributing"),
 		)
-		s.finalizer = NewFinalizer(
-			s.db,
-			s.coreConnector,
-			s.logger.WithField("phase", "finalizing"),
+		s.finalizer = s.newFinalizer(
 			s.self.Account.CosmosAddress() == s.sessionLeader,
+			s.logger.WithField("phase", "finalizing"),
 		)
 		s.mu.Unlock()
 
 		s.logger.Info(fmt.Sprintf("waiting for next signing session %s to start in %s", s.Id(), time.Until(s.nextSessionStartTime)))
 
+		paused := session.Paused(s.pause, s.nextSessionStartTime)
 		select {
 		case <-ctx.Done():
 			s.logger.Info("signing session cancelled")
@@ -162,6 +192,12 @@ func (s *Session) Run(ctx context.Context) error {
 			s.nextSessionStartTime = s.nextSessionStartTime.Add(session.BoundarySigningSession)
 		}
 
+		if paused {
+			s.logger.Info(fmt.Sprintf("signing session %s skipped due to the pause", s.Id()))
+			s.incrementSessionId()
+			continue
+		}
+
 		s.logger.Info(fmt.Sprintf("signing session %s started", s.Id()))
 		if err := s.runSession(ctx); err != nil {
 			s.logger.WithError(err).Error("failed to run signing session")
@@ -172,7 +208,7 @@ func (s *Session) Run(ctx context.Context) error {
 	}
 }
 
-func (s *Session) runSession(ctx context.Context) (err error) {
+func (s *Session[T]) runSession(ctx context.Context) (err error) {
 	// consensus phase
 	consensusCtx, consCtxCancel := context.WithTimeout(ctx, session.BoundaryConsensus)
 	defer consCtxCancel()
@@ -187,16 +223,19 @@ func (s *Session) runSession(ctx context.Context) (err error) {
 		return nil
 	}
 
-	if err = s.db.UpdateStatus(result.SigData.DepositIdentifier(), types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSING); err != nil {
-		return errors.Wrap(err, "failed to update deposit status")
+	identifiers := (*result.SigData).DepositIdentifiers()
+	if err = UpdateDepositsStatus(s.db, identifiers, types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSING); err != nil {
+		return errors.Wrap(err, "failed to update deposits status")
 	}
 	defer func() {
 		// compensating status update in case of error
 		if err != nil {
-			_ = s.db.UpdateStatus(result.SigData.DepositIdentifier(), types.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED)
+			_ = UpdateDepositsStatus(s.db, identifiers, types.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED)
 		}
 	}()
 
+	sigData := (*result.SigData).SigData()
+
 	var (
 		distributionCtx    context.Context
 		distributionCancel context.CancelFunc
@@ -209,16 +248,14 @@ func (s *Session) runSession(ctx context.Context) (err error) {
 
 		s.signingParty.
 			WithParties(result.Signers).
-			WithSigningData(result.SigData.ProposalData.SigData).
+			WithSigningData(sigData).
 			Run(signingCtx)
-		signature := s.signingParty.WaitFor()
-		if signature == nil {
+		signed := s.signingParty.WaitFor()
+		if signed == nil {
 			return errors.New("signing phase error occurred")
 		}
 
-		signatures = &tss.Signatures{
-			Data: []*common.SignatureData{signature},
-		}
+		signatures = &tss.Signatures{Data: signed}
 
 		// signature distribution phase should be started not later than
 		// a second after the signing phase
@@ -235,40 +272,59 @@ func (s *Session) runSession(ctx context.Context) (err error) {
 
 	s.signaturesDistributor.
 		WithSignatures(signatures).
-		WithSigData([][]byte{result.SigData.ProposalData.SigData}).
+		WithSigData(sigData).
 		Run(distributionCtx)
 	signatures, err = s.signaturesDistributor.WaitFor()
 	if err != nil {
 		return errors.Wrap(err, "signature distribution phase error occurred")
 	}
+	if len(signatures.Data) != len(sigData) {
+		return errors.New("signatures count does not match sig data count")
+	}
 
 	// finalization phase
 	finalizerCtx, finalizerCancel := context.WithTimeout(context.Background(), session.BoundaryFinalize)
 	defer finalizerCancel()
 
-	err = s.finalizer.
-		WithData(result.SigData).
-		WithSignature(signatures.Data[0]).
-		Finalize(finalizerCtx)
-	if err != nil {
+	if err = s.finalize(finalizerCtx, result.SigData, signatures.Data); err != nil {
 		return errors.Wrap(err, "finalizer phase error occurred")
 	}
 
 	return nil
 }
 
-func (s *Session) Id() string {
+func (s *Session[T]) finalize(ctx context.Context, data *T, signatures []*common.SignatureData) error {
+	logger := s.logger.WithField("phase", "finalizing")
+	logger.Info("finalization started")
+
+	// buffered to not leak the finalizer goroutine on timeout
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.finalizer.Finalize(ctx, data, signatures)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "finalization timed out")
+	case err := <-errChan:
+		logger.Info("finalization finished")
+
+		return errors.Wrap(err, "failed to finalize withdrawal")
+	}
+}
+
+func (s *Session[T]) Id() string {
 	return s.sessionId.Load()
 }
 
-func (s *Session) incrementSessionId() {
+func (s *Session[T]) incrementSessionId() {
 	prevSessionId := s.Id()
 	nextSessionId := session.IncrementSessionIdentifier(prevSessionId)
 	s.sessionId.Store(nextSessionId)
 	s.idChangeListener(prevSessionId, nextSessionId)
 }
 
-func (s *Session) Receive(request *p2p.SubmitRequest) error {
+func (s *Session[T]) Receive(request *p2p.SubmitRequest) error {
 	if request == nil {
 		return errors.New("nil request")
 	}
@@ -307,11 +363,11 @@ func (s *Session) Receive(request *p2p.SubmitRequest) error {
 	}
 }
 
-func (s *Session) RegisterIdChangeListener(f func(oldId string, newId string)) {
+func (s *Session[T]) RegisterIdChangeListener(f func(oldId string, newId string)) {
 	s.idChangeListener = f
 }
 
-func (s *Session) SigningSessionInfo() *p2p.SigningSessionInfo {
+func (s *Session[T]) SigningSessionInfo() *p2p.SigningSessionInfo {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
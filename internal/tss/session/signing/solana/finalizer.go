@@ -2,80 +2,101 @@ package solana
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/solana"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
-	coreConnector "github.com/Bridgeless-Project/tss-svc/internal/core/connector"
 	database "github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/logan/v3"
 )
 
+var _ signing.Finalizer[withdrawal.SolanaWithdrawalData] = &Finalizer{}
+
+// Finalizer stores the signatures, the signed withdrawals are claimed by the users
+// unless the relayer is configured.
 type Finalizer struct {
-	withdrawalData *withdrawal.SolanaWithdrawalData
-	signature      *common.SignatureData
+	db database.DepositsQ
 
-	db   database.DepositsQ
-	core *coreConnector.Connector
+	// relayer is optional, if set, the session leader sends the signed withdrawals to the bridge program
+	relayer *solana.Relayer
 
 	sessionLeader bool
 
-	errChan chan error
-
 	logger *logan.Entry
 }
 
-func NewFinalizer(
-	db database.DepositsQ,
-	core *coreConnector.Connector,
-	logger *logan.Entry,
-	sessionLeader bool) *Finalizer {
-	return &Finalizer{
-		db:            db,
-		core:          core,
-		errChan:       make(chan error),
-		logger:        logger,
-		sessionLeader: sessionLeader,
+// NewFinalizerFactory creates the Solana finalizers, the relayer is optional.
+func NewFinalizerFactory(db database.DepositsQ, relayer *solana.Relayer) signing.FinalizerFactory[withdrawal.SolanaWithdrawalData] {
+	return func(sessionLeader bool, logger *logan.Entry) signing.Finalizer[withdrawal.SolanaWithdrawalData] {
+		return &Finalizer{
+			db:            db,
+			relayer:       relayer,
+			sessionLeader: sessionLeader,
+			logger:        logger,
+		}
 	}
 }
 
-func (f *Finalizer) WithData(withdrawalData *withdrawal.SolanaWithdrawalData) *Finalizer {
-	f.withdrawalData = withdrawalData
-	return f
-}
+func (f *Finalizer) Finalize(_ context.Context, data *withdrawal.SolanaWithdrawalData, signatures []*common.SignatureData) error {
+	identifiers := data.DepositIdentifiers()
+	if len(identifiers) != len(signatures) {
+		return errors.New("signatures count does not match deposits count")
+	}
 
-func (f *Finalizer) WithSignature(sig *common.SignatureData) *Finalizer {
-	f.signature = sig
-	return f
-}
+	converted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		converted[i] = convertToSolanaSignature(signatures[i])
+		if err := f.db.UpdateProcessed(database.ProcessedDepositData{
+			Identifier: identifier,
+			Signature:  &converted[i],
+		}); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to update signature for deposit %s", identifier))
+		}
+	}
 
-func (f *Finalizer) Finalize(ctx context.Context) error {
-	f.logger.Info("finalization started")
-	go f.finalize(ctx)
+	if !f.sessionLeader || f.relayer == nil {
+		return nil
+	}
 
-	// listen for ctx and errors
-	select {
-	case <-ctx.Done():
-		return errors.Wrap(ctx.Err(), "finalization timed out")
-	case err := <-f.errChan:
-		f.logger.Info("finalization finished")
+	// the confirmation outlives the finalization phase deadline,
+	// relaying failure does not invalidate the signed withdrawal, it still can be claimed by the user manually
+	go func() {
+		for i, identifier := range identifiers {
+			if err := f.relay(identifier, converted[i]); err != nil {
+				f.logger.WithError(err).Errorf("failed to relay withdrawal for deposit %s", identifier)
+			}
+		}
+	}()
+
+	return nil
+}
 
-		return errors.Wrap(err, "failed to finalize withdrawal")
+func (f *Finalizer) relay(identifier database.DepositIdentifier, signature string) error {
+	deposit, err := f.db.Get(identifier)
+	if err != nil {
+		return errors.Wrap(err, "failed to get deposit")
+	}
+	if deposit == nil {
+		return errors.New("deposit not found")
 	}
-}
 
-func (f *Finalizer) finalize(_ context.Context) {
-	signature := convertToSolanaSignature(f.signature)
-	if err := f.db.UpdateProcessed(database.ProcessedDepositData{
-		Identifier: f.withdrawalData.DepositIdentifier(),
-		Signature:  &signature,
-	}); err != nil {
-		f.errChan <- errors.Wrap(err, "failed to update signature")
-		return
+	txSig, err := f.relayer.Relay(context.Background(), *deposit, hexutil.MustDecode(signature))
+	if err != nil {
+		return errors.Wrap(err, "failed to relay withdrawal transaction")
 	}
 
-	f.errChan <- nil
+	txHash := txSig.String()
+	if err = f.db.UpdateWithdrawalTxHash(identifier, txHash); err != nil {
+		return errors.Wrap(err, "failed to update withdrawal transaction hash")
+	}
+
+	f.logger.Infof("withdrawal transaction %s confirmed", txHash)
+
+	return nil
 }
 
 func convertToSolanaSignature(sig *common.SignatureData) string {
@@ -2,78 +2,105 @@ package ton
 
 import (
 	"context"
+	"fmt"
 
 	tonchain "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/ton"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
-	coreConnector "github.com/Bridgeless-Project/tss-svc/internal/core/connector"
 	database "github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
 	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/logan/v3"
 )
 
+var _ signing.Finalizer[withdrawal.TonWithdrawalData] = &Finalizer{}
+
+// Finalizer stores the signatures, the signed withdrawals are claimed by the users
+// unless the relayer is configured.
 type Finalizer struct {
-	withdrawalData *withdrawal.TonWithdrawalData
-	signature      *common.SignatureData
+	db database.DepositsQ
 
-	db   database.DepositsQ
-	core *coreConnector.Connector
+	// relayer is optional, if set, the session leader sends the signed withdrawals to the bridge contract
+	relayer *tonchain.Relayer
 
 	sessionLeader bool
 
-	errChan chan error
-
 	logger *logan.Entry
 }
 
-func NewFinalizer(
-	db database.DepositsQ,
-	core *coreConnector.Connector,
-	logger *logan.Entry,
-	sessionLeader bool) *Finalizer {
-	return &Finalizer{
-		db:            db,
-		core:          core,
-		errChan:       make(chan error),
-		logger:        logger,
-		sessionLeader: sessionLeader,
+// NewFinalizerFactory creates the TON finalizers, the relayer is optional.
+func NewFinalizerFactory(db database.DepositsQ, relayer *tonchain.Relayer) signing.FinalizerFactory[withdrawal.TonWithdrawalData] {
+	return func(sessionLeader bool, logger *logan.Entry) signing.Finalizer[withdrawal.TonWithdrawalData] {
+		return &Finalizer{
+			db:            db,
+			relayer:       relayer,
+			sessionLeader: sessionLeader,
+			logger:        logger,
+		}
 	}
 }
 
-func (tf *Finalizer) WithData(withdrawalData *withdrawal.TonWithdrawalData) *Finalizer {
-	tf.withdrawalData = withdrawalData
-	return tf
-}
+func (f *Finalizer) Finalize(_ context.Context, data *withdrawal.TonWithdrawalData, signatures []*common.SignatureData) error {
+	identifiers := data.DepositIdentifiers()
+	if len(identifiers) != len(signatures) {
+		return errors.New("signatures count does not match deposits count")
+	}
 
-func (tf *Finalizer) WithSignature(sig *common.SignatureData) *Finalizer {
-	tf.signature = sig
-	return tf
-}
+	converted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		converted[i] = tonchain.СonvertToTonSignature(signatures[i])
+		if err := f.db.UpdateProcessed(database.ProcessedDepositData{
+			Identifier: identifier,
+			Signature:  &converted[i],
+		}); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("failed to update signature for deposit %s", identifier))
+		}
+	}
 
-func (tf *Finalizer) Finalize(ctx context.Context) error {
-	tf.logger.Info("finalization started")
-	go tf.finalize(ctx)
+	if !f.sessionLeader || f.relayer == nil {
+		return nil
+	}
 
-	// listen for ctx and errors
-	select {
-	case <-ctx.Done():
-		return errors.Wrap(ctx.Err(), "finalization timed out")
-	case err := <-tf.errChan:
-		tf.logger.Info("finalization finished")
+	// the wallet transaction outlives the finalization phase deadline,
+	// relaying failure does not invalidate the signed withdrawal, it still can be claimed by the user manually
+	go func() {
+		for i, identifier := range identifiers {
+			if err := f.relay(identifier, converted[i]); err != nil {
+				f.logger.WithError(err).Errorf("failed to relay withdrawal for deposit %s", identifier)
+			}
+		}
+	}()
 
-		return errors.Wrap(err, "failed to finalize withdrawal")
-	}
+	return nil
 }
 
-func (tf *Finalizer) finalize(_ context.Context) {
-	signature := tonchain.СonvertToTonSignature(tf.signature)
-	if err := tf.db.UpdateProcessed(database.ProcessedDepositData{
-		Identifier: tf.withdrawalData.DepositIdentifier(),
-		Signature:  &signature,
-	}); err != nil {
-		tf.errChan <- errors.Wrap(err, "failed to update signature")
-		return
+func (f *Finalizer) relay(identifier database.DepositIdentifier, signature string) error {
+	deposit, err := f.db.Get(identifier)
+	if err != nil {
+		return errors.Wrap(err, "failed to get deposit")
 	}
+	if deposit == nil {
+		return errors.New("deposit not found")
+	}
+
+	tx, err := f.relayer.Relay(context.Background(), *deposit, hexutil.MustDecode(signature))
+	if err != nil {
+		return errors.Wrap(err, "failed to relay withdrawal message")
+	}
+
+	// the TON transactions are looked up by both the lt and the hash,
+	// the lt is kept locally only as the core transaction has no field for it,
+	// so it is saved before the hash update schedules the core resubmission
+	if err = f.db.UpdateWithdrawalTxNonce(identifier, int64(tx.LT)); err != nil {
+		return errors.Wrap(err, "failed to update withdrawal transaction lt")
+	}
+	txHash := hexutil.Encode(tx.Hash)
+	if err = f.db.UpdateWithdrawalTxHash(identifier, txHash); err != nil {
+		return errors.Wrap(err, "failed to update withdrawal transaction hash")
+	}
+
+	f.logger.Infof("withdrawal transaction %s (lt %d) confirmed", txHash, tx.LT)
 
-	tf.errChan <- nil
+	return nil
 }
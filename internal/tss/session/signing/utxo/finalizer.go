@@ -83,7 +83,7 @@ func (f *Finalizer) finalize(_ context.Context) {
 		f.errChan <- errors.Wrap(err, "failed to deserialize transaction")
 		return
 	}
-	if err := f.client.UtxoHelper().InjectSignatures(tx, f.signatures, f.tssPub); err != nil {
+	if err := f.client.UtxoHelper().InjectSignatures(tx, f.withdrawalData.ProposalData.PrevScripts, f.signatures, f.tssPub); err != nil {
 		f.errChan <- errors.Wrap(err, "failed to inject signatures")
 		return
 	}
@@ -93,13 +93,18 @@ func (f *Finalizer) finalize(_ context.Context) {
 	withdrawalTxHash := bridge.HexPrefix + f.client.UtxoHelper().TxHash(tx)
 	encodedTx := utils.EncodeTransaction(tx)
 
-	if err := f.db.UpdateProcessed(database.ProcessedDepositData{
-		Identifier: f.withdrawalData.DepositIdentifier(),
-		TxData:     &encodedTx,
-		TxHash:     &withdrawalTxHash,
-	}); err != nil {
-		f.errChan <- errors.Wrap(err, "failed to update signature")
-		return
+	for _, identifier := range f.withdrawalData.DepositIdentifiers() {
+		if err := f.db.UpdateProcessed(database.ProcessedDepositData{
+			Identifier: identifier,
+			TxData:     &encodedTx,
+			TxHash:     &withdrawalTxHash,
+			// confirmation is tracked only to bump the fee of the stuck transaction
+			PendingConfirmation: f.client.ReplaceByFee().Enabled,
+			Resubmit:            f.withdrawalData.IsReplacement(),
+		}); err != nil {
+			f.errChan <- errors.Wrap(err, "failed to update signature")
+			return
+		}
 	}
 
 	if !f.sessionLeader {
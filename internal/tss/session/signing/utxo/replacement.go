@@ -0,0 +1,280 @@
+package utxo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/consensus"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+var _ consensus.Mechanism[withdrawal.UtxoWithdrawalData] = &ReplacementMechanism{}
+
+var (
+	errTxConfirmed = errors.New("transaction is already confirmed")
+	errTxNotStuck  = errors.New("transaction is not stuck yet")
+)
+
+// ReplacementMechanism extends the withdrawal consensus mechanism with the fee bumping
+// of the stuck withdrawal transactions. A withdrawal transaction that remains unconfirmed
+// longer than the configured threshold is replaced (BIP-125) by the one spending
+// the same inputs at a higher fee rate. Replacements are proposed before any new deposits.
+type ReplacementMechanism struct {
+	chainId     string
+	base        consensus.Mechanism[withdrawal.UtxoWithdrawalData]
+	depositsQ   db.DepositsQ
+	client      client.Client
+	constructor *withdrawal.UtxoWithdrawalConstructor
+	stuckAfter  time.Duration
+	logger      *logan.Entry
+}
+
+func NewReplacementMechanism(
+	chainId string,
+	base consensus.Mechanism[withdrawal.UtxoWithdrawalData],
+	depositsQ db.DepositsQ,
+	client client.Client,
+	constructor *withdrawal.UtxoWithdrawalConstructor,
+	logger *logan.Entry,
+) *ReplacementMechanism {
+	return &ReplacementMechanism{
+		chainId:     chainId,
+		base:        base,
+		depositsQ:   depositsQ,
+		client:      client,
+		constructor: constructor,
+		stuckAfter:  client.ReplaceByFee().StuckAfter,
+		logger:      logger,
+	}
+}
+
+type stuckTransaction struct {
+	tx       *wire.MsgTx
+	prevOuts []*wire.TxOut
+	feeRate  btcutil.Amount
+}
+
+func (m *ReplacementMechanism) FormProposalData() (*withdrawal.UtxoWithdrawalData, error) {
+	data, err := m.formReplacementData()
+	if err != nil {
+		// failing to bump the fee should not block the regular withdrawals
+		m.logger.WithError(err).Error("failed to form replacement data")
+	} else if data != nil {
+		return data, nil
+	}
+
+	return m.base.FormProposalData()
+}
+
+func (m *ReplacementMechanism) VerifyProposedData(data withdrawal.UtxoWithdrawalData) error {
+	if !data.IsReplacement() {
+		return m.base.VerifyProposedData(data)
+	}
+
+	identifiers := data.DepositIdentifiers()
+	if len(identifiers) == 0 {
+		return errors.New("no deposits proposed")
+	}
+
+	replacedTxHash := data.ProposalData.ReplacedTxHash
+	replaced, err := m.depositsQ.Select(db.DepositsSelector{
+		WithdrawalChainId: &m.chainId,
+		WithdrawalTxHash:  &replacedTxHash,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get replaced deposits")
+	}
+	if len(replaced) != len(identifiers) {
+		return errors.New(fmt.Sprintf("replaced deposits count mismatch: expected %d, got %d", len(replaced), len(identifiers)))
+	}
+
+	byIdentifier := make(map[db.DepositIdentifier]db.Deposit, len(replaced))
+	for _, deposit := range replaced {
+		byIdentifier[deposit.DepositIdentifier] = deposit
+	}
+	deposits := make([]db.Deposit, len(identifiers))
+	for i, identifier := range identifiers {
+		deposit, found := byIdentifier[identifier]
+		if !found {
+			return errors.New(fmt.Sprintf("deposit %s is not withdrawn by the replaced transaction", identifier))
+		}
+		if deposit.WithdrawalStatus != types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED {
+			return errors.New(fmt.Sprintf("deposit %s is not in processed status", identifier))
+		}
+
+		// preventing duplicates
+		delete(byIdentifier, identifier)
+		deposits[i] = deposit
+	}
+
+	stuck, err := m.getStuckTransaction(replacedTxHash, deposits)
+	if err != nil {
+		return errors.Wrap(err, "invalid replaced transaction")
+	}
+
+	feeRate := btcutil.Amount(data.ProposalData.FeeRate)
+	if !helper.FeeRateValid(m.client.UtxoHelper(), feeRate) || feeRate <= stuck.feeRate {
+		return errors.New(fmt.Sprintf("invalid replacement fee rate %d, replaced one is %d", feeRate, stuck.feeRate))
+	}
+
+	expected, err := m.constructor.FormReplacementData(replacedTxHash, stuck.tx, stuck.prevOuts, deposits, feeRate)
+	if err != nil {
+		return errors.Wrap(err, "failed to form replacement data")
+	}
+	if expected.HashString() != data.HashString() {
+		return errors.New("proposed replacement does not match the expected one")
+	}
+
+	return nil
+}
+
+// formReplacementData looks through the unconfirmed withdrawal transactions,
+// releasing the confirmed ones and forming the replacement for the first stuck one.
+func (m *ReplacementMechanism) formReplacementData() (*withdrawal.UtxoWithdrawalData, error) {
+	processedStatus := types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED
+	pending, err := m.depositsQ.Select(db.DepositsSelector{
+		WithdrawalChainId:   &m.chainId,
+		Status:              &processedStatus,
+		PendingConfirmation: true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get unconfirmed withdrawals")
+	}
+
+	sort.Slice(pending, func(i, j int) bool { return pending[i].Id < pending[j].Id })
+
+	var hashes []string
+	withdrawals := make(map[string][]db.Deposit)
+	for _, deposit := range pending {
+		if deposit.WithdrawalTxHash == nil {
+			continue
+		}
+
+		hash := *deposit.WithdrawalTxHash
+		if _, exists := withdrawals[hash]; !exists {
+			hashes = append(hashes, hash)
+		}
+		withdrawals[hash] = append(withdrawals[hash], deposit)
+	}
+
+	for _, hash := range hashes {
+		logger := m.logger.WithField("withdrawal_tx_hash", hash)
+		deposits := withdrawals[hash]
+
+		stuck, err := m.getStuckTransaction(hash, deposits)
+		switch {
+		case errors.Is(err, errTxConfirmed):
+			for _, deposit := range deposits {
+				if err = m.depositsQ.UpdatePendingConfirmation(deposit.DepositIdentifier, false); err != nil {
+					return nil, errors.Wrap(err, "failed to update pending confirmation")
+				}
+			}
+			logger.Info("withdrawal transaction confirmed")
+			continue
+		case errors.Is(err, errTxNotStuck):
+			continue
+		case err != nil:
+			logger.WithError(err).Warn("failed to check withdrawal transaction")
+			continue
+		}
+
+		feeRate := m.client.EstimateFeeOrDefault()
+		hlp := m.client.UtxoHelper()
+		if minFeeRate := stuck.feeRate + hlp.MinFeeRate(); feeRate < minFeeRate {
+			feeRate = minFeeRate
+		}
+		if feeRate > hlp.MaxFeeRate() {
+			feeRate = hlp.MaxFeeRate()
+		}
+		if feeRate <= stuck.feeRate {
+			logger.Warn("stuck withdrawal transaction fee rate cannot be bumped anymore")
+			continue
+		}
+
+		logger.Infof("replacing stuck withdrawal transaction, fee rate %d -> %d", stuck.feeRate, feeRate)
+
+		return m.constructor.FormReplacementData(hash, stuck.tx, stuck.prevOuts, deposits, feeRate)
+	}
+
+	return nil, nil
+}
+
+// getStuckTransaction returns the withdrawal transaction if it is unconfirmed
+// longer than the configured threshold. The threshold is counted from the time the transaction
+// was saved during the finalization, so the parties do not depend on their mempool views.
+func (m *ReplacementMechanism) getStuckTransaction(hash string, deposits []db.Deposit) (*stuckTransaction, error) {
+	txInfo, err := m.client.GetTransaction(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get transaction")
+	}
+	if txInfo.Confirmations > 0 {
+		return nil, errTxConfirmed
+	}
+
+	if len(deposits) == 0 || deposits[0].PendingSince == nil {
+		return nil, errors.New("withdrawal transaction broadcast time not found")
+	}
+	if time.Since(*deposits[0].PendingSince) < m.stuckAfter {
+		return nil, errTxNotStuck
+	}
+
+	if deposits[0].TxData == nil {
+		return nil, errors.New("withdrawal transaction data not found")
+	}
+	tx, err := utils.DecodeTransaction(*deposits[0].TxData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode withdrawal transaction")
+	}
+
+	prevOuts := make([]*wire.TxOut, len(tx.TxIn))
+	fee := int64(0)
+	for i, in := range tx.TxIn {
+		if prevOuts[i], err = m.getPrevOut(in.PreviousOutPoint); err != nil {
+			return nil, errors.Wrapf(err, "failed to get previous output for input %d", i)
+		}
+		fee += prevOuts[i].Value
+	}
+	for _, out := range tx.TxOut {
+		fee -= out.Value
+	}
+
+	return &stuckTransaction{
+		tx:       tx,
+		prevOuts: prevOuts,
+		feeRate:  btcutil.Amount(fee * 1000 / mempool.GetTxVirtualSize(btcutil.NewTx(tx))),
+	}, nil
+}
+
+func (m *ReplacementMechanism) getPrevOut(outPoint wire.OutPoint) (*wire.TxOut, error) {
+	prevTx, err := m.client.GetTransaction(outPoint.Hash.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get previous transaction")
+	}
+	if int(outPoint.Index) >= len(prevTx.Vout) {
+		return nil, errors.New("previous output index out of range")
+	}
+
+	out := prevTx.Vout[outPoint.Index]
+	script, err := hex.DecodeString(out.ScriptPubKey.Hex)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode previous output script")
+	}
+	value, err := btcutil.NewAmount(out.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse previous output value")
+	}
+
+	return wire.NewTxOut(int64(value), script), nil
+}
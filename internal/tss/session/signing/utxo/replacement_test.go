@@ -0,0 +1,306 @@
+package utxo
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	bridgeTypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain"
+	utxochain "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/chain"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
+	utxotypes "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/types"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
+	"github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/types"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/crypto"
+	"gitlab.com/distributed_lab/logan/v3"
+)
+
+const stuckAfter = time.Hour
+
+type mockClient struct {
+	client.Client
+
+	helper  helper.UtxoHelper
+	txs     map[string]*btcjson.TxRawResult
+	feeRate btcutil.Amount
+}
+
+func (m mockClient) GetTransaction(txHash string) (*btcjson.TxRawResult, error) {
+	tx, ok := m.txs[strings.TrimPrefix(txHash, "0x")]
+	if !ok {
+		return nil, bridgeTypes.ErrTxNotFound
+	}
+
+	return tx, nil
+}
+
+func (m mockClient) EstimateFeeOrDefault() btcutil.Amount {
+	return m.feeRate
+}
+
+func (m mockClient) ReplaceByFee() utxochain.ReplaceByFee {
+	return utxochain.ReplaceByFee{Enabled: true, StuckAfter: stuckAfter}
+}
+
+func (m mockClient) UtxoHelper() helper.UtxoHelper {
+	return m.helper
+}
+
+type mockDepositsQ struct {
+	db.DepositsQ
+
+	deposits []db.Deposit
+	released []db.DepositIdentifier
+}
+
+func (m *mockDepositsQ) Select(db.DepositsSelector) ([]db.Deposit, error) {
+	return m.deposits, nil
+}
+
+func (m *mockDepositsQ) UpdatePendingConfirmation(identifier db.DepositIdentifier, pending bool) error {
+	if !pending {
+		m.released = append(m.released, identifier)
+	}
+
+	return nil
+}
+
+// mockMechanism proposes and accepts the regular withdrawal data.
+type mockMechanism struct{}
+
+func (mockMechanism) FormProposalData() (*withdrawal.UtxoWithdrawalData, error) {
+	return &withdrawal.UtxoWithdrawalData{}, nil
+}
+
+func (mockMechanism) VerifyProposedData(withdrawal.UtxoWithdrawalData) error {
+	return nil
+}
+
+type replacementSetup struct {
+	confirmations uint64
+	stuckFor      time.Duration
+	stuckFee      int64
+	feeRate       btcutil.Amount
+}
+
+type replacementEnv struct {
+	mechanism   *ReplacementMechanism
+	depositsQ   *mockDepositsQ
+	constructor *withdrawal.UtxoWithdrawalConstructor
+	stuckHash   string
+	stuckTx     *wire.MsgTx
+	prevOuts    []*wire.TxOut
+	stuckRate   btcutil.Amount
+}
+
+func newReplacementEnv(t *testing.T, setup replacementSetup) replacementEnv {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	bridgeClient := client.NewBridgeClient(utxochain.Chain{
+		Meta: utxochain.Meta{
+			Chain:   utxotypes.ChainBtc,
+			Network: utxotypes.NetworkTestnet3,
+		},
+	})
+	hlp := bridgeClient.UtxoHelper()
+	address := hlp.P2pkhAddress(&key.PublicKey)
+	script, err := hlp.PayToAddrScript(address)
+	if err != nil {
+		t.Fatalf("failed to create script: %v", err)
+	}
+
+	prevHash, _ := chainhash.NewHashFromStr("05837c626141191c42210876396c846dfd604dc32e219c82c517ebac8bd7d0eb")
+	prevOut := wire.NewTxOut(20000, script)
+
+	stuckTx := wire.NewMsgTx(wire.TxVersion)
+	stuckTx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Hash: *prevHash, Index: 0}, Sequence: utils.ReplaceableSequenceNum})
+	stuckTx.AddTxOut(wire.NewTxOut(5000-setup.stuckFee, script))
+	stuckTx.AddTxOut(wire.NewTxOut(15000, script))
+	stuckHash := "0x" + stuckTx.TxHash().String()
+
+	encodedTx := utils.EncodeTransaction(stuckTx)
+	pendingSince := time.Now().Add(-setup.stuckFor)
+	depositsQ := &mockDepositsQ{deposits: []db.Deposit{{
+		Id:                1,
+		DepositIdentifier: db.DepositIdentifier{TxHash: "0x01", ChainId: "btc"},
+		Receiver:          address,
+		WithdrawalAmount:  "5000",
+		WithdrawalStatus:  types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSED,
+		WithdrawalTxHash:  &stuckHash,
+		TxData:            &encodedTx,
+		PendingSince:      &pendingSince,
+	}}}
+
+	mock := mockClient{
+		helper:  hlp,
+		feeRate: setup.feeRate,
+		txs: map[string]*btcjson.TxRawResult{
+			stuckTx.TxHash().String(): {Confirmations: setup.confirmations},
+			prevHash.String(): {Vout: []btcjson.Vout{{
+				Value:        btcutil.Amount(prevOut.Value).ToBTC(),
+				ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(script)},
+			}}},
+		},
+	}
+	constructor := withdrawal.NewUtxoConstructor(bridgeClient, &key.PublicKey)
+
+	return replacementEnv{
+		mechanism:   NewReplacementMechanism("btc", mockMechanism{}, depositsQ, mock, constructor, logan.New()),
+		depositsQ:   depositsQ,
+		constructor: constructor,
+		stuckHash:   stuckHash,
+		stuckTx:     stuckTx,
+		prevOuts:    []*wire.TxOut{prevOut},
+		stuckRate:   btcutil.Amount(setup.stuckFee * 1000 / mempool.GetTxVirtualSize(btcutil.NewTx(stuckTx))),
+	}
+}
+
+func Test_FormReplacementProposal(t *testing.T) {
+	maxFeeRate := utils.MaxFeeRateBtcPerKvb
+	minFeeRate := utils.DefaultFeeRateBtcPerKvb
+
+	tests := map[string]struct {
+		setup       replacementSetup
+		replacement bool
+		released    bool
+		// expectedFeeRate returns the replacement fee rate given the stuck transaction one
+		expectedFeeRate func(stuckRate btcutil.Amount) btcutil.Amount
+	}{
+		"confirmed transaction released": {
+			setup:    replacementSetup{confirmations: 1, stuckFor: 2 * stuckAfter, stuckFee: 300, feeRate: minFeeRate},
+			released: true,
+		},
+		"transaction not stuck": {
+			setup: replacementSetup{stuckFor: stuckAfter / 2, stuckFee: 300, feeRate: minFeeRate},
+		},
+		"fee rate bumped by the minimum one": {
+			setup:           replacementSetup{stuckFor: 2 * stuckAfter, stuckFee: 300, feeRate: minFeeRate},
+			replacement:     true,
+			expectedFeeRate: func(stuckRate btcutil.Amount) btcutil.Amount { return stuckRate + minFeeRate },
+		},
+		"fee rate capped by the maximum one": {
+			setup:           replacementSetup{stuckFor: 2 * stuckAfter, stuckFee: 300, feeRate: 10 * maxFeeRate},
+			replacement:     true,
+			expectedFeeRate: func(btcutil.Amount) btcutil.Amount { return maxFeeRate },
+		},
+		"fee rate cannot be bumped": {
+			setup: replacementSetup{stuckFor: 2 * stuckAfter, stuckFee: 700, feeRate: 10 * maxFeeRate},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newReplacementEnv(t, tc.setup)
+
+			data, err := env.mechanism.FormProposalData()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if data.IsReplacement() != tc.replacement {
+				t.Fatalf("expected replacement %v, got %v", tc.replacement, data.IsReplacement())
+			}
+			if released := len(env.depositsQ.released) > 0; released != tc.released {
+				t.Fatalf("expected released %v, got %v", tc.released, released)
+			}
+			if !tc.replacement {
+				return
+			}
+
+			if data.ProposalData.ReplacedTxHash != env.stuckHash {
+				t.Fatalf("expected replaced hash %s, got %s", env.stuckHash, data.ProposalData.ReplacedTxHash)
+			}
+			if expected := tc.expectedFeeRate(env.stuckRate); btcutil.Amount(data.ProposalData.FeeRate) != expected {
+				t.Fatalf("expected fee rate %d, got %d", expected, data.ProposalData.FeeRate)
+			}
+		})
+	}
+}
+
+func Test_VerifyReplacementProposal(t *testing.T) {
+	setup := replacementSetup{stuckFor: 2 * stuckAfter, stuckFee: 300, feeRate: utils.DefaultFeeRateBtcPerKvb}
+
+	tests := map[string]struct {
+		// proposal forms the data proposed for the stuck transaction
+		proposal func(env replacementEnv) *withdrawal.UtxoWithdrawalData
+		err      bool
+	}{
+		"regular withdrawal": {
+			proposal: func(replacementEnv) *withdrawal.UtxoWithdrawalData { return &withdrawal.UtxoWithdrawalData{} },
+		},
+		"matching replacement": {
+			proposal: func(env replacementEnv) *withdrawal.UtxoWithdrawalData {
+				return formReplacement(t, env, env.depositsQ.deposits, 4000)
+			},
+		},
+		"fee rate not bumped": {
+			proposal: func(env replacementEnv) *withdrawal.UtxoWithdrawalData {
+				return formReplacement(t, env, env.depositsQ.deposits, env.stuckRate)
+			},
+			err: true,
+		},
+		"fee rate above the maximum": {
+			proposal: func(env replacementEnv) *withdrawal.UtxoWithdrawalData {
+				return formReplacement(t, env, env.depositsQ.deposits, 2*utils.MaxFeeRateBtcPerKvb)
+			},
+			err: true,
+		},
+		"transaction not matching the fee rate": {
+			proposal: func(env replacementEnv) *withdrawal.UtxoWithdrawalData {
+				data := formReplacement(t, env, env.depositsQ.deposits, 4000)
+				data.ProposalData.FeeRate = 4500
+
+				return data
+			},
+			err: true,
+		},
+		"deposit not withdrawn by the replaced transaction": {
+			proposal: func(env replacementEnv) *withdrawal.UtxoWithdrawalData {
+				deposit := env.depositsQ.deposits[0]
+				deposit.TxHash = "0x02"
+
+				return formReplacement(t, env, []db.Deposit{deposit}, 4000)
+			},
+			err: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newReplacementEnv(t, setup)
+
+			err := env.mechanism.VerifyProposedData(*tc.proposal(env))
+			if err != nil {
+				if !tc.err {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+			if tc.err {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
+
+func formReplacement(t *testing.T, env replacementEnv, deposits []db.Deposit, feeRate btcutil.Amount) *withdrawal.UtxoWithdrawalData {
+	data, err := env.constructor.FormReplacementData(env.stuckHash, env.stuckTx, env.prevOuts, deposits, feeRate)
+	if err != nil {
+		t.Fatalf("failed to form replacement data: %v", err)
+	}
+
+	return data
+}
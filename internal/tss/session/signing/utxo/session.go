@@ -29,13 +29,19 @@ import (
 
 var _ p2p.TssSession = &Session{}
 
+const (
+	sessionKindSigning int32 = iota
+	sessionKindConsolidation
+	sessionKindSweeping
+)
+
 type Session struct {
 	sessionId                    *atomic.String
 	sessionLeader                core.Address
 	nextSessionStartTime         time.Time
 	nextSessionStartTimeConstant *atomic.Bool
 	idChangeListener             func(oldId string, newId string)
-	isSignSession                *atomic.Bool
+	sessionKind                  *atomic.Int32
 	mu                           *sync.RWMutex
 
 	parties        []p2p.Party
@@ -43,17 +49,21 @@ type Session struct {
 	self           tss.LocalSignParty
 	db             db.DepositsQ
 	params         session.SigningParams
+	pause          session.Pause
 	logger         *logan.Entry
 
 	coreConnector *connector.Connector
 	fetcher       *deposit.Fetcher
 	client        client.Client
+	batchSize     uint64
 
 	signConsMechanism          consensus.Mechanism[withdrawal.UtxoWithdrawalData]
 	consolidationConsMechanism consensus.Mechanism[resharingConsensus.SigningData]
+	sweepingConsMechanism      *SweepingMechanism
 
 	signConsParty          *consensus.Consensus[withdrawal.UtxoWithdrawalData]
 	consolidationConsParty *consensus.Consensus[resharingConsensus.SigningData]
+	sweepingConsParty      *consensus.Consensus[resharingConsensus.SigningData]
 
 	signaturesDistributor *signing.SignaturesDistributor
 
@@ -74,7 +84,7 @@ func NewSession(
 
 	return &Session{
 		sessionId:                    atomic.NewString(sessionId),
-		isSignSession:                atomic.NewBool(true),
+		sessionKind:                  atomic.NewInt32(sessionKindSigning),
 		mu:                           &sync.RWMutex{},
 		nextSessionStartTime:         params.StartTime,
 		nextSessionStartTimeConstant: atomic.NewBool(true),
@@ -99,6 +109,18 @@ func (s *Session) WithClient(client client.Client) *Session {
 	return s
 }
 
+// WithPause skips the signing sessions overlapping the pause. Optional.
+func (s *Session) WithPause(pause session.Pause) *Session {
+	s.pause = pause
+	return s
+}
+
+// WithBatchSize limits the number of deposits withdrawn by one transaction. Optional, the protocol maximum by default.
+func (s *Session) WithBatchSize(batchSize uint64) *Session {
+	s.batchSize = batchSize
+	return s
+}
+
 func (s *Session) WithCoreConnector(conn *connector.Connector) *Session {
 	s.coreConnector = conn
 	return s
@@ -115,19 +137,43 @@ func (s *Session) Build() error {
 	if s.coreConnector == nil {
 		return errors.New("core connector is not set")
 	}
+	if s.batchSize == 0 {
+		s.batchSize = session.MaxSigningBatchSize
+	}
+	if s.batchSize > session.MaxSigningBatchSize {
+		return errors.New(fmt.Sprintf("batch size %d exceeds the maximum %d", s.batchSize, session.MaxSigningBatchSize))
+	}
 
+	constructor := withdrawal.NewUtxoConstructor(s.client, s.self.Share.ECDSAPub.ToECDSAPubKey())
 	s.signConsMechanism = signing.NewConsensusMechanism[withdrawal.UtxoWithdrawalData](
 		s.params.ChainId,
 		s.db,
-		withdrawal.NewUtxoConstructor(s.client, s.self.Share.ECDSAPub.ToECDSAPubKey()),
+		constructor,
 		s.fetcher,
+		s.batchSize, // batched deposits are withdrawn by one transaction with multiple outputs
 	)
+	if s.client.ReplaceByFee().Enabled {
+		s.signConsMechanism = NewReplacementMechanism(
+			s.params.ChainId,
+			s.signConsMechanism,
+			s.db,
+			s.client,
+			constructor,
+			s.logger.WithField("component", "replacement"),
+		)
+	}
 
+	walletAddress := s.client.UtxoHelper().WalletAddress(s.self.Share.ECDSAPub.ToECDSAPubKey())
+	consolidateParams := utxoutils.DefaultConsolidateOutputsParams
+	consolidateParams.FeeRate = uint64(s.client.UtxoHelper().MinFeeRate())
 	s.consolidationConsMechanism = resharingConsensus.NewConsensusMechanism(
 		s.client,
-		s.client.UtxoHelper().P2pkhAddress(s.self.Share.ECDSAPub.ToECDSAPubKey()),
-		utxoutils.DefaultConsolidateOutputsParams,
+		walletAddress,
+		consolidateParams,
 	)
+	if s.client.DepositAddressesEnabled() {
+		s.sweepingConsMechanism = NewSweepingMechanism(s.client, walletAddress, consolidateParams)
+	}
 
 	return nil
 }
@@ -173,6 +219,19 @@ func (s *Session) Run(ctx context.Context) error {
 			s.consolidationConsMechanism,
 			s.logger.WithField("phase", "consensus"),
 		)
+		if s.sweepingConsMechanism != nil {
+			s.sweepingConsParty = consensus.New[resharingConsensus.SigningData](
+				consensus.LocalConsensusParty{
+					SessionId: s.Id(),
+					Threshold: s.self.Threshold,
+					Self:      s.self.Account,
+				},
+				s.parties,
+				s.sessionLeader,
+				s.sweepingConsMechanism,
+				s.logger.WithField("phase", "consensus"),
+			)
+		}
 		s.consolidationFinalizer = resharingConsensus.NewFinalizer(
 			s.client, s.self.Share.ECDSAPub.ToECDSAPubKey(),
 			s.logger.WithField("phase", "finalizing"),
@@ -190,6 +249,7 @@ func (s *Session) Run(ctx context.Context) error {
 
 		s.logger.Info(fmt.Sprintf("waiting for next session to start in %s", time.Until(s.nextSessionStartTime)))
 
+		paused := session.Paused(s.pause, s.nextSessionStartTime)
 		select {
 		case <-ctx.Done():
 			s.logger.Info("session cancelled")
@@ -202,24 +262,37 @@ func (s *Session) Run(ctx context.Context) error {
 			s.mu.Unlock()
 		}
 
+		if paused {
+			s.logger.Info(fmt.Sprintf("signing session %s skipped due to the pause", s.Id()))
+			s.nextSessionStartTimeConstant.Store(true)
+			s.incrementSessionId()
+			continue
+		}
+
 		// define the next session type
 		unspentCount, err := s.client.UnspentCount()
 		if err != nil {
 			s.logger.WithError(err).Error("failed to get unspent count")
 			s.logger.Info("starting signing session")
-			s.isSignSession.Store(true)
+			s.sessionKind.Store(sessionKindSigning)
 		} else if unspentCount > s.client.ConsolidationThreshold() {
 			s.logger.Info("starting consolidation session")
-			s.isSignSession.Store(false)
+			s.sessionKind.Store(sessionKindConsolidation)
+		} else if s.sweepingConsParty != nil && session.GetSessionId(s.Id())%session.SweepingSessionInterval == 0 {
+			s.logger.Info("starting sweeping session")
+			s.sessionKind.Store(sessionKindSweeping)
 		} else {
 			s.logger.Info("starting signing session")
-			s.isSignSession.Store(true)
+			s.sessionKind.Store(sessionKindSigning)
 		}
 
-		if s.isSignSession.Load() {
-			err = s.runSigningSession(ctx)
-		} else {
+		switch s.sessionKind.Load() {
+		case sessionKindConsolidation:
 			err = s.runConsolidationSession(ctx)
+		case sessionKindSweeping:
+			err = s.runSweepingSession(ctx)
+		default:
+			err = s.runSigningSession(ctx)
 		}
 		if err != nil {
 			s.logger.WithError(err).Error("session error occurred")
@@ -246,17 +319,26 @@ func (s *Session) runSigningSession(ctx context.Context) (err error) {
 	}
 
 	signRounds := len(result.SigData.ProposalData.SigData)
-	s.updateNextSessionStartTime(signRounds)
-
-	if err = s.db.UpdateStatus(result.SigData.DepositIdentifier(), types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSING); err != nil {
-		return errors.Wrap(err, "failed to update deposit status")
+	if !s.updateNextSessionStartTime(signRounds) {
+		s.logger.Info("session extension overlaps the pause, skipping the session")
+		return nil
 	}
-	defer func() {
-		// compensating status update in case of error
-		if err != nil {
-			_ = s.db.UpdateStatus(result.SigData.DepositIdentifier(), types.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED)
+
+	// replaced deposits are already processed and remain so if the replacement fails
+	if result.SigData.IsReplacement() {
+		s.logger.Infof("replacing stuck withdrawal transaction %s", result.SigData.ProposalData.ReplacedTxHash)
+	} else {
+		identifiers := result.SigData.DepositIdentifiers()
+		if err = signing.UpdateDepositsStatus(s.db, identifiers, types.WithdrawalStatus_WITHDRAWAL_STATUS_PROCESSING); err != nil {
+			return errors.Wrap(err, "failed to update deposits status")
 		}
-	}()
+		defer func() {
+			// compensating status update in case of error
+			if err != nil {
+				_ = signing.UpdateDepositsStatus(s.db, identifiers, types.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED)
+			}
+		}()
+	}
 
 	var (
 		distributionCtx    context.Context
@@ -351,8 +433,61 @@ func (s *Session) runConsolidationSession(ctx context.Context) error {
 		return nil
 	}
 
+	return s.signTransfer(ctx, result, s.self, s.consolidationFinalizer, "consolidation")
+}
+
+// runSweepingSession moves the funds of a single deposit address to the TSS wallet.
+// The inputs are signed by the child key controlling the deposit address.
+func (s *Session) runSweepingSession(ctx context.Context) error {
+	// consensus phase
+	consensusCtx, consCtxCancel := context.WithTimeout(ctx, session.BoundaryConsensus)
+	defer consCtxCancel()
+
+	s.sweepingConsParty.Run(consensusCtx)
+	result, err := s.sweepingConsParty.WaitFor()
+	if err != nil {
+		return errors.Wrap(err, "consensus phase error occurred")
+	}
+	if result.SigData == nil {
+		s.logger.Info("no data to sign in the current session")
+		return nil
+	}
+
+	key, err := s.sweepingConsMechanism.Key(*result.SigData)
+	if err != nil {
+		return errors.Wrap(err, "failed to get deposit address key")
+	}
+
+	// signing party is already receiving messages, so it is updated instead of being replaced
+	self := s.self.WithKeyDerivation(key)
+	s.mu.Lock()
+	s.signingParty.WithKeyDerivation(key)
+	s.signaturesDistributor.WithSigner(self)
+	s.mu.Unlock()
+
+	finalizer := resharingConsensus.NewFinalizer(
+		s.client, key.PublicKey,
+		s.logger.WithField("phase", "finalizing"),
+		s.self.Account.CosmosAddress() == s.sessionLeader,
+	)
+
+	return s.signTransfer(ctx, result, self, finalizer, "deposit address sweeping")
+}
+
+// signTransfer signs the transaction agreed on during the consensus by the given party
+// and broadcasts it with the finalizer.
+func (s *Session) signTransfer(
+	ctx context.Context,
+	result consensus.SigningSessionData[resharingConsensus.SigningData],
+	self tss.LocalSignParty,
+	finalizer *resharingConsensus.Finalizer,
+	kind string,
+) error {
 	signRounds := len(result.SigData.ProposalData.SigData)
-	s.updateNextSessionStartTime(signRounds)
+	if !s.updateNextSessionStartTime(signRounds) {
+		s.logger.Info("session extension overlaps the pause, skipping the session")
+		return nil
+	}
 
 	var (
 		distributionCtx    context.Context
@@ -384,7 +519,7 @@ func (s *Session) runConsolidationSession(ctx context.Context) error {
 			}
 
 			s.mu.Lock()
-			s.signingParty = tss.NewSignParty(s.self, s.Id(), s.logger.WithField("phase", "signing"))
+			s.signingParty = tss.NewSignParty(self, s.Id(), s.logger.WithField("phase", "signing"))
 			s.mu.Unlock()
 
 			select {
@@ -413,7 +548,7 @@ func (s *Session) runConsolidationSession(ctx context.Context) error {
 		WithSignatures(signatures).
 		WithSigData(result.SigData.ProposalData.SigData).
 		Run(distributionCtx)
-	signatures, err = s.signaturesDistributor.WaitFor()
+	signatures, err := s.signaturesDistributor.WaitFor()
 	if err != nil {
 		return errors.Wrap(err, "signature distribution phase error occurred")
 	}
@@ -422,7 +557,7 @@ func (s *Session) runConsolidationSession(ctx context.Context) error {
 	finalizerCtx, finalizerCancel := context.WithTimeout(context.Background(), session.BoundaryFinalize)
 	defer finalizerCancel()
 
-	txHash, err := s.consolidationFinalizer.
+	txHash, err := finalizer.
 		WithData(result.SigData).
 		WithSignatures(signatures.Data).
 		Finalize(finalizerCtx)
@@ -430,7 +565,7 @@ func (s *Session) runConsolidationSession(ctx context.Context) error {
 		return errors.Wrap(err, "finalizer phase error occurred")
 	}
 
-	s.logger.Infof("consolidation has been successfully processed:  %s", txHash)
+	s.logger.Infof("%s has been successfully processed: %s", kind, txHash)
 
 	return nil
 }
@@ -456,10 +591,13 @@ func (s *Session) Receive(request *p2p.SubmitRequest) error {
 		var err error
 
 		s.mu.RLock()
-		if s.isSignSession.Load() {
-			err = s.signConsParty.Receive(request)
-		} else {
+		switch s.sessionKind.Load() {
+		case sessionKindConsolidation:
 			err = s.consolidationConsParty.Receive(request)
+		case sessionKindSweeping:
+			err = s.sweepingConsParty.Receive(request)
+		default:
+			err = s.signConsParty.Receive(request)
 		}
 		s.mu.RUnlock()
 
@@ -502,20 +640,27 @@ func (s *Session) RegisterIdChangeListener(f func(oldId string, newId string)) {
 // standard session flow: consensus -> signing (1) -> signature distribution -> finalizing
 // if the number of inputs to sign is greater than 1, the next session start time
 // should be recalculated to include additional signing phases and
-// delays to re-setup the signing party to ensure the correct request handling
-func (s *Session) updateNextSessionStartTime(inputsToSign int) {
+// delays to re-setup the signing party to ensure the correct request handling.
+// The session is not extended if the extension overlaps the pause, false is returned then.
+func (s *Session) updateNextSessionStartTime(inputsToSign int) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	s.nextSessionStartTimeConstant.Store(true)
 
 	if inputsToSign <= 1 {
-		return
+		return true
 	}
 
 	// excluding included consensus, finalizing, and one signing phase
 	additionalDelay := time.Duration(inputsToSign-1) * (session.BoundarySign + session.BoundaryBitcoinSignRoundDelay)
+	if s.pause != nil && s.pause.Overlaps(s.nextSessionStartTime, s.nextSessionStartTime.Add(additionalDelay)) {
+		return false
+	}
+
 	s.nextSessionStartTime = s.nextSessionStartTime.Add(additionalDelay)
+
+	return true
 }
 
 func (s *Session) SigningSessionInfo() *p2p.SigningSessionInfo {
@@ -0,0 +1,243 @@
+package utxo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/client"
+	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/helper"
+	utxoutils "github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/utxo/utils"
+	"github.com/Bridgeless-Project/tss-svc/internal/p2p"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/consensus"
+	resharingConsensus "github.com/Bridgeless-Project/tss-svc/internal/tss/session/resharing/utxo"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+)
+
+var _ consensus.Mechanism[resharingConsensus.SigningData] = &SweepingMechanism{}
+
+// SweepingMechanism forms the transactions moving the funds from the deposit addresses
+// to the TSS wallet address. A transaction spends the outputs of a single deposit address,
+// so all its inputs are signed by the same derived key.
+type SweepingMechanism struct {
+	client  client.Client
+	helper  helper.UtxoHelper
+	dstAddr string
+	params  utxoutils.ConsolidateOutputsParams
+}
+
+func NewSweepingMechanism(client client.Client, dst string, params utxoutils.ConsolidateOutputsParams) *SweepingMechanism {
+	helper := client.UtxoHelper()
+	if _, err := helper.PayToAddrScript(dst); err != nil {
+		panic(errors.Wrapf(err, "failed to create script for destination address %s", dst))
+	}
+
+	return &SweepingMechanism{
+		client:  client,
+		helper:  helper,
+		dstAddr: dst,
+		params:  params,
+	}
+}
+
+func (m *SweepingMechanism) FormProposalData() (*resharingConsensus.SigningData, error) {
+	unspent, err := m.client.ListDepositAddressesUnspent()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list deposit addresses unspent outputs")
+	}
+
+	selected := m.selectUnspent(unspent)
+	if len(selected) == 0 {
+		return nil, nil
+	}
+
+	tx, sigHashes, prevScripts, err := m.sweepOutputs(selected)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sweep outputs")
+	}
+	if tx == nil {
+		// the deposit address funds do not cover the fee yet
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	if err = tx.Serialize(&buf); err != nil {
+		return nil, errors.Wrap(err, "failed to serialize transaction")
+	}
+
+	return &resharingConsensus.SigningData{
+		ProposalData: &p2p.BitcoinResharingProposalData{
+			SerializedTx: buf.Bytes(),
+			SigData:      sigHashes,
+			PrevScripts:  prevScripts,
+		},
+	}, nil
+}
+
+// selectUnspent selects the outputs of the deposit address holding the most funds.
+func (m *SweepingMechanism) selectUnspent(unspent []btcjson.ListUnspentResult) []btcjson.ListUnspentResult {
+	totals := make(map[string]int64)
+	for _, out := range unspent {
+		totals[out.Address] += utxoutils.ToUnits(out.Amount)
+	}
+
+	var selectedAddr string
+	for addr, total := range totals {
+		if selectedAddr == "" || total > totals[selectedAddr] || (total == totals[selectedAddr] && addr < selectedAddr) {
+			selectedAddr = addr
+		}
+	}
+
+	selected := make([]btcjson.ListUnspentResult, 0)
+	for _, out := range unspent {
+		if out.Address == selectedAddr {
+			selected = append(selected, out)
+		}
+	}
+
+	selected = m.helper.ArrangeOutputs(selected)
+	if len(selected) > m.params.MaxInputsCount {
+		selected = selected[:m.params.MaxInputsCount]
+	}
+
+	return selected
+}
+
+// sweepOutputs forms the transaction spending the given outputs in the same order.
+// The nil transaction is returned if the swept amount is too small.
+func (m *SweepingMechanism) sweepOutputs(unspent []btcjson.ListUnspentResult) (*wire.MsgTx, [][]byte, [][]byte, error) {
+	receiverScript, _ := m.helper.PayToAddrScript(m.dstAddr)
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxOut(wire.NewTxOut(0, receiverScript))
+
+	totalAmount := int64(0)
+	prevScripts := make([][]byte, len(unspent))
+	for i, out := range unspent {
+		hash, err := chainhash.NewHashFromStr(out.TxID)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("failed to parse tx hash for input %d", i))
+		}
+
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, out.Vout), nil, nil))
+		totalAmount += utxoutils.ToUnits(out.Amount)
+
+		prevScripts[i], err = hex.DecodeString(out.ScriptPubKey)
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("failed to decode script for input %d", i))
+		}
+	}
+
+	fees := m.helper.EstimateFee(tx, prevScripts, btcutil.Amount(m.params.FeeRate))
+	tx.TxOut[0].Value = totalAmount - int64(fees)
+	if !m.client.WithdrawalAmountValid(big.NewInt(tx.TxOut[0].Value)) {
+		return nil, nil, nil, nil
+	}
+
+	sigHashes := make([][]byte, len(tx.TxIn))
+	for i, out := range unspent {
+		sigHash, err := m.helper.CalculateSignatureHash(prevScripts[i], tx, i, utxoutils.ToUnits(out.Amount))
+		if err != nil {
+			return nil, nil, nil, errors.Wrap(err, fmt.Sprintf("failed to calculate signature hash for input %d", i))
+		}
+
+		sigHashes[i] = sigHash
+	}
+
+	return tx, sigHashes, prevScripts, nil
+}
+
+func (m *SweepingMechanism) VerifyProposedData(data resharingConsensus.SigningData) error {
+	if data.ProposalData == nil {
+		return errors.New("empty proposal data")
+	}
+
+	tx := wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(data.ProposalData.SerializedTx)); err != nil {
+		return errors.Wrap(err, "failed to deserialize transaction")
+	}
+	if len(tx.TxIn) == 0 || len(tx.TxIn) > m.params.MaxInputsCount {
+		return errors.New("invalid inputs count in the transaction")
+	}
+
+	used, err := m.usedInputs(tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to find used inputs in the transaction")
+	}
+
+	originalTx, sigHashes, prevScripts, err := m.sweepOutputs(used)
+	if err != nil {
+		return errors.Wrap(err, "failed to sweep outputs from used inputs")
+	}
+	if originalTx == nil {
+		return errors.New("swept amount is too small")
+	}
+
+	var buf bytes.Buffer
+	if err = originalTx.Serialize(&buf); err != nil {
+		return errors.Wrap(err, "failed to serialize original transaction")
+	}
+	if !bytes.Equal(buf.Bytes(), data.ProposalData.SerializedTx) {
+		return errors.New("provided transaction does not match the expected one")
+	}
+	if len(sigHashes) != len(data.ProposalData.SigData) {
+		return errors.New("signature hashes number mismatch")
+	}
+	for i := range data.ProposalData.SigData {
+		if !bytes.Equal(data.ProposalData.SigData[i], sigHashes[i]) {
+			return errors.Errorf("signature hash mismatch at index %d", i)
+		}
+	}
+	if len(prevScripts) != len(data.ProposalData.PrevScripts) {
+		return errors.New("previous scripts number mismatch")
+	}
+	for i := range data.ProposalData.PrevScripts {
+		if !bytes.Equal(data.ProposalData.PrevScripts[i], prevScripts[i]) {
+			return errors.Errorf("previous script mismatch at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Key returns the derived key controlling the deposit address swept by the transaction.
+func (m *SweepingMechanism) Key(data resharingConsensus.SigningData) (*tss.KeyDerivation, error) {
+	tx := wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(data.ProposalData.SerializedTx)); err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize transaction")
+	}
+
+	used, err := m.usedInputs(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find used inputs in the transaction")
+	}
+
+	return m.client.DepositAddressKey(used[0].Address)
+}
+
+// usedInputs returns the deposit address outputs spent by the transaction
+// ensuring all of them belong to the same address.
+func (m *SweepingMechanism) usedInputs(tx wire.MsgTx) ([]btcjson.ListUnspentResult, error) {
+	unspent, err := m.client.ListDepositAddressesUnspent()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list deposit addresses unspent outputs")
+	}
+
+	used, err := utxoutils.FindUsedInputs(tx, unspent)
+	if err != nil {
+		return nil, err
+	}
+	for _, out := range used {
+		if out.Address != used[0].Address {
+			return nil, errors.New("transaction spends outputs of multiple deposit addresses")
+		}
+	}
+
+	return used, nil
+}
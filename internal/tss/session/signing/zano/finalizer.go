@@ -6,98 +6,65 @@ import (
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/chain/zano"
 	"github.com/Bridgeless-Project/tss-svc/internal/bridge/withdrawal"
-	coreConnector "github.com/Bridgeless-Project/tss-svc/internal/core/connector"
 	database "github.com/Bridgeless-Project/tss-svc/internal/db"
+	"github.com/Bridgeless-Project/tss-svc/internal/tss/session/signing"
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/logan/v3"
 )
 
-type Finalizer struct {
-	withdrawalData *withdrawal.ZanoWithdrawalData
-	signature      *common.SignatureData
-
-	db   database.DepositsQ
-	core *coreConnector.Connector
+var _ signing.Finalizer[withdrawal.ZanoWithdrawalData] = &Finalizer{}
 
+type Finalizer struct {
+	db     database.DepositsQ
 	client *zano.Client
 
 	sessionLeader bool
-
-	errChan chan error
-	logger  *logan.Entry
 }
 
-func NewFinalizer(
-	db database.DepositsQ,
-	core *coreConnector.Connector,
-	client *zano.Client,
-	logger *logan.Entry,
-	sessionLeader bool) *Finalizer {
-	return &Finalizer{
-		db:            db,
-		core:          core,
-		errChan:       make(chan error),
-		logger:        logger,
-		client:        client,
-		sessionLeader: sessionLeader,
+func NewFinalizerFactory(db database.DepositsQ, client *zano.Client) signing.FinalizerFactory[withdrawal.ZanoWithdrawalData] {
+	return func(sessionLeader bool, _ *logan.Entry) signing.Finalizer[withdrawal.ZanoWithdrawalData] {
+		return &Finalizer{
+			db:            db,
+			client:        client,
+			sessionLeader: sessionLeader,
+		}
 	}
 }
 
-func (f *Finalizer) WithData(withdrawalData *withdrawal.ZanoWithdrawalData) *Finalizer {
-	f.withdrawalData = withdrawalData
-	return f
-}
-
-func (f *Finalizer) WithSignature(signature *common.SignatureData) *Finalizer {
-	f.signature = signature
-	return f
-}
-
-func (f *Finalizer) Finalize(ctx context.Context) error {
-	f.logger.Info("finalization started")
-	go f.finalize(ctx)
-
-	select {
-	case <-ctx.Done():
-		return errors.Wrap(ctx.Err(), "finalization timed out")
-	case err := <-f.errChan:
-		f.logger.Info("finalization finished")
-
-		return errors.Wrap(err, "failed to finalize withdrawal")
+func (f *Finalizer) Finalize(_ context.Context, data *withdrawal.ZanoWithdrawalData, signatures []*common.SignatureData) error {
+	if len(signatures) != 1 {
+		return errors.New("exactly one signature expected")
 	}
-}
 
-func (f *Finalizer) finalize(_ context.Context) {
-	withdrawalTxHash := bridge.HexPrefix + f.withdrawalData.ProposalData.TxId
+	withdrawalTxHash := bridge.HexPrefix + data.ProposalData.TxId
 	signedTx := zano.SignedTransaction{
-		Signature: zano.EncodeSignature(f.signature),
+		Signature: zano.EncodeSignature(signatures[0]),
 		UnsignedTransaction: zano.UnsignedTransaction{
-			ExpectedTxHash: f.withdrawalData.ProposalData.TxId,
-			FinalizedTx:    f.withdrawalData.ProposalData.FinalizedTx,
-			Data:           f.withdrawalData.ProposalData.UnsignedTx,
+			ExpectedTxHash: data.ProposalData.TxId,
+			FinalizedTx:    data.ProposalData.FinalizedTx,
+			Data:           data.ProposalData.UnsignedTx,
 		},
 	}
 	encodedTx := signedTx.Encode()
 
-	if err := f.db.UpdateProcessed(database.ProcessedDepositData{
-		Identifier: f.withdrawalData.DepositIdentifier(),
-		TxData:     &encodedTx,
-		TxHash:     &withdrawalTxHash,
-	}); err != nil {
-		f.errChan <- errors.Wrap(err, "failed to update signature")
-		return
+	for _, identifier := range data.DepositIdentifiers() {
+		if err := f.db.UpdateProcessed(database.ProcessedDepositData{
+			Identifier: identifier,
+			TxData:     &encodedTx,
+			TxHash:     &withdrawalTxHash,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update signature")
+		}
 	}
 
 	if !f.sessionLeader {
-		f.errChan <- nil
-		return
+		return nil
 	}
 
 	if _, err := f.client.SendSignedTransaction(signedTx); err != nil {
-		f.errChan <- errors.Wrap(err, "failed to emit signed transaction")
-		return
+		return errors.Wrap(err, "failed to emit signed transaction")
 	}
 
-	f.errChan <- nil
+	return nil
 }
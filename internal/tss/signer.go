@@ -12,15 +12,53 @@ import (
 	"github.com/bnb-chain/tss-lib/v2/common"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/v2/ecdsa/signing"
+	eddsaKeygen "github.com/bnb-chain/tss-lib/v2/eddsa/keygen"
+	eddsaSigning "github.com/bnb-chain/tss-lib/v2/eddsa/signing"
 	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/pkg/errors"
 	"gitlab.com/distributed_lab/logan/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
 type LocalSignParty struct {
-	Account   core.Account
-	Share     *keygen.LocalPartySaveData
+	Account core.Account
+	Share   *keygen.LocalPartySaveData
+	// EddsaShare is required only if the party signs with the Ed25519 curve
+	EddsaShare *eddsaKeygen.LocalPartySaveData
+	// Curve defines the key used to sign, secp256k1 by default
+	Curve     Curve
 	Threshold int
+	// KeyDerivation defines the child key to sign with instead of the secp256k1 TSS key. Optional.
+	KeyDerivation *KeyDerivation
+}
+
+// WithKeyDerivation returns the copy of the party signing with the derived child key.
+func (p LocalSignParty) WithKeyDerivation(derivation *KeyDerivation) LocalSignParty {
+	p.KeyDerivation = derivation
+	return p
+}
+
+// VerifySignature verifies the signature against the party key of the configured curve.
+func (p LocalSignParty) VerifySignature(data []byte, signature *common.SignatureData) bool {
+	if p.Curve == CurveEd25519 {
+		pub := p.EddsaShare.EDDSAPub
+		return VerifyEddsa(Ed25519PubKey(pub.X(), pub.Y()), data, signature)
+	}
+	if p.KeyDerivation != nil {
+		return Verify(p.KeyDerivation.PublicKey, data, signature)
+	}
+
+	return Verify(p.Share.ECDSAPub.ToECDSAPubKey(), data, signature)
+}
+
+// KeyGeneration returns the generation of the party key share, see core.Address.PartyKeyAt.
+func (p LocalSignParty) KeyGeneration() uint64 {
+	// EdDSA shares are not reshared
+	if p.Curve == CurveEd25519 {
+		return 0
+	}
+
+	return core.KeyGeneration(p.Share.ShareID)
 }
 
 type SignParty struct {
@@ -37,9 +75,12 @@ type SignParty struct {
 	broadcaster *broadcast.Broadcaster
 
 	data []byte
+	// index of the party within the batch signing, zero for a standalone signing
+	index int32
 
 	ended     atomic.Bool
 	result    *common.SignatureData
+	err       error
 	sessionId string
 }
 
@@ -56,11 +97,12 @@ func NewSignParty(self LocalSignParty, sessionId string, logger *logan.Entry) *S
 func (p *SignParty) WithParties(parties []p2p.Party) *SignParty {
 	partyMap := make(map[core.Address]struct{}, len(parties))
 	partyIds := make([]*tss.PartyID, len(parties)+1)
-	partyIds[0] = p.self.Account.CosmosAddress().PartyIdentifier()
+	generation := p.self.KeyGeneration()
+	partyIds[0] = p.self.Account.CosmosAddress().PartyIdentifierAt(generation)
 
 	for i, party := range parties {
 		partyMap[party.CoreAddress] = struct{}{}
-		partyIds[i+1] = party.Identifier()
+		partyIds[i+1] = party.CoreAddress.PartyIdentifierAt(generation)
 	}
 
 	p.parties = partyMap
@@ -75,17 +117,45 @@ func (p *SignParty) WithSigningData(data []byte) *SignParty {
 	return p
 }
 
+// WithKeyDerivation makes the party sign with the derived child key.
+// The messages received before are kept, so it is safe to call before Run.
+func (p *SignParty) WithKeyDerivation(derivation *KeyDerivation) *SignParty {
+	p.self.KeyDerivation = derivation
+	return p
+}
+
+func (p *SignParty) WithIndex(index int32) *SignParty {
+	p.index = index
+	return p
+}
+
 func (p *SignParty) Run(ctx context.Context) {
 	params := tss.NewParameters(
-		tss.S256(), tss.NewPeerContext(p.sortedPartyIds),
-		p.sortedPartyIds.FindByKey(p.self.Account.CosmosAddress().PartyKey()),
+		p.self.Curve.ellipticCurve(), tss.NewPeerContext(p.sortedPartyIds),
+		p.sortedPartyIds.FindByKey(p.self.Account.CosmosAddress().PartyKeyAt(p.self.KeyGeneration())),
 		len(p.sortedPartyIds),
 		p.self.Threshold,
 	)
 	out := make(chan tss.Message, OutChannelSize)
 	end := make(chan *common.SignatureData, EndChannelSize)
 
-	p.party = signing.NewLocalParty(new(big.Int).SetBytes(p.data), params, *p.self.Share, out, end)
+	msg := new(big.Int).SetBytes(p.data)
+	if p.self.Curve == CurveEd25519 {
+		// the whole message is signed by EdDSA, so leading zeros must be preserved
+		p.party = eddsaSigning.NewLocalParty(msg, params, *p.self.EddsaShare, out, end, len(p.data))
+	} else if p.self.KeyDerivation != nil {
+		key, err := derivedKey(*p.self.Share, p.self.KeyDerivation)
+		if err != nil {
+			p.err = errors.Wrap(err, "failed to derive child key share")
+			p.ended.Store(true)
+			// the messages of the other parties are dropped, so the senders are not blocked
+			go p.drainMsgs(ctx)
+			return
+		}
+		p.party = signing.NewLocalPartyWithKDD(msg, params, key, p.self.KeyDerivation.Delta, out, end)
+	} else {
+		p.party = signing.NewLocalParty(msg, params, *p.self.Share, out, end)
+	}
 
 	p.wg.Add(3)
 
@@ -107,6 +177,11 @@ func (p *SignParty) WaitFor() *common.SignatureData {
 	p.wg.Wait()
 	p.ended.Store(true)
 
+	if p.err != nil {
+		p.logger.WithError(p.err).Error("signing failed")
+		return nil
+	}
+
 	p.logger.Info("signing finished")
 
 	return p.result
@@ -125,6 +200,17 @@ func (p *SignParty) Receive(sender core.Address, data *p2p.TssData) {
 	}
 }
 
+// drainMsgs drops the messages received by the party that failed to start
+func (p *SignParty) drainMsgs(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.msgs:
+		}
+	}
+}
+
 // receiveMsgs receives message from msg chan and updates party`s internal state
 func (p *SignParty) receiveMsgs(ctx context.Context) {
 	defer p.wg.Done()
@@ -144,7 +230,7 @@ func (p *SignParty) receiveMsgs(ctx context.Context) {
 				continue
 			}
 
-			_, err := p.party.UpdateFromBytes(msg.WireMsg, p.sortedPartyIds.FindByKey(msg.Sender.PartyKey()), msg.IsBroadcast)
+			_, err := p.party.UpdateFromBytes(msg.WireMsg, p.sortedPartyIds.FindByKey(msg.Sender.PartyKeyAt(p.self.KeyGeneration())), msg.IsBroadcast)
 			if err != nil {
 				p.logger.WithError(err).Error("failed to update party state")
 			}
@@ -179,6 +265,7 @@ func (p *SignParty) receiveUpdates(ctx context.Context, out <-chan tss.Message,
 			tssData := &p2p.TssData{
 				Data:        raw,
 				IsBroadcast: routing.IsBroadcast,
+				Index:       p.index,
 			}
 
 			tssReq, _ := anypb.New(tssData)
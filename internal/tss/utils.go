@@ -2,9 +2,12 @@ package tss
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/v2/common"
+	"github.com/bnb-chain/tss-lib/v2/tss"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
 )
 
 func Verify(pk *ecdsa.PublicKey, inputData []byte, signature *common.SignatureData) bool {
@@ -13,3 +16,13 @@ func Verify(pk *ecdsa.PublicKey, inputData []byte, signature *common.SignatureDa
 
 	return ecdsa.Verify(pk, data.Bytes(), r, s)
 }
+
+func VerifyEddsa(pk ed25519.PublicKey, inputData []byte, signature *common.SignatureData) bool {
+	return ed25519.Verify(pk, inputData, signature.Signature)
+}
+
+// Ed25519PubKey converts the TSS EdDSA public key point into the Ed25519 public key.
+func Ed25519PubKey(x, y *big.Int) ed25519.PublicKey {
+	pub := edwards.PublicKey{Curve: tss.Edwards(), X: x, Y: y}
+	return pub.SerializeCompressed()
+}
@@ -0,0 +1,1085 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: plugin.proto
+
+package plugin
+
+import (
+	types "github.com/Bridgeless-Project/tss-svc/internal/types"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DepositError int32
+
+const (
+	DepositError_DEPOSIT_ERROR_UNSPECIFIED              DepositError = 0
+	DepositError_DEPOSIT_ERROR_TX_PENDING               DepositError = 1
+	DepositError_DEPOSIT_ERROR_TX_NOT_CONFIRMED         DepositError = 2
+	DepositError_DEPOSIT_ERROR_TX_FAILED                DepositError = 3
+	DepositError_DEPOSIT_ERROR_TX_NOT_FOUND             DepositError = 4
+	DepositError_DEPOSIT_ERROR_DEPOSIT_NOT_FOUND        DepositError = 5
+	DepositError_DEPOSIT_ERROR_INVALID_RECEIVER_ADDRESS DepositError = 6
+	DepositError_DEPOSIT_ERROR_INVALID_DEPOSITED_AMOUNT DepositError = 7
+	DepositError_DEPOSIT_ERROR_UNSUPPORTED_EVENT        DepositError = 8
+	DepositError_DEPOSIT_ERROR_UNSUPPORTED_CONTRACT     DepositError = 9
+)
+
+// Enum value maps for DepositError.
+var (
+	DepositError_name = map[int32]string{
+		0: "DEPOSIT_ERROR_UNSPECIFIED",
+		1: "DEPOSIT_ERROR_TX_PENDING",
+		2: "DEPOSIT_ERROR_TX_NOT_CONFIRMED",
+		3: "DEPOSIT_ERROR_TX_FAILED",
+		4: "DEPOSIT_ERROR_TX_NOT_FOUND",
+		5: "DEPOSIT_ERROR_DEPOSIT_NOT_FOUND",
+		6: "DEPOSIT_ERROR_INVALID_RECEIVER_ADDRESS",
+		7: "DEPOSIT_ERROR_INVALID_DEPOSITED_AMOUNT",
+		8: "DEPOSIT_ERROR_UNSUPPORTED_EVENT",
+		9: "DEPOSIT_ERROR_UNSUPPORTED_CONTRACT",
+	}
+	DepositError_value = map[string]int32{
+		"DEPOSIT_ERROR_UNSPECIFIED":              0,
+		"DEPOSIT_ERROR_TX_PENDING":               1,
+		"DEPOSIT_ERROR_TX_NOT_CONFIRMED":         2,
+		"DEPOSIT_ERROR_TX_FAILED":                3,
+		"DEPOSIT_ERROR_TX_NOT_FOUND":             4,
+		"DEPOSIT_ERROR_DEPOSIT_NOT_FOUND":        5,
+		"DEPOSIT_ERROR_INVALID_RECEIVER_ADDRESS": 6,
+		"DEPOSIT_ERROR_INVALID_DEPOSITED_AMOUNT": 7,
+		"DEPOSIT_ERROR_UNSUPPORTED_EVENT":        8,
+		"DEPOSIT_ERROR_UNSUPPORTED_CONTRACT":     9,
+	}
+)
+
+func (x DepositError) Enum() *DepositError {
+	p := new(DepositError)
+	*p = x
+	return p
+}
+
+func (x DepositError) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositError) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_enumTypes[0].Descriptor()
+}
+
+func (DepositError) Type() protoreflect.EnumType {
+	return &file_plugin_proto_enumTypes[0]
+}
+
+func (x DepositError) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositError.Descriptor instead.
+func (DepositError) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+type ValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValueRequest) Reset() {
+	*x = ValueRequest{}
+	mi := &file_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueRequest) ProtoMessage() {}
+
+func (x *ValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueRequest.ProtoReflect.Descriptor instead.
+func (*ValueRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValueRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ValidityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Valid bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// optional reason of the invalidity, used for logging only
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidityResponse) Reset() {
+	*x = ValidityResponse{}
+	mi := &file_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidityResponse) ProtoMessage() {}
+
+func (x *ValidityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidityResponse.ProtoReflect.Descriptor instead.
+func (*ValidityResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidityResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidityResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DepositData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         int64                  `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	SourceAddress string                 `protobuf:"bytes,2,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// decimal amount in the token base units
+	DepositAmount      string `protobuf:"bytes,3,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	TokenAddress       string `protobuf:"bytes,4,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	ReferralId         uint32 `protobuf:"varint,5,opt,name=referral_id,json=referralId,proto3" json:"referral_id,omitempty"`
+	DestinationAddress string `protobuf:"bytes,6,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	DestinationChainId string `protobuf:"bytes,7,opt,name=destination_chain_id,json=destinationChainId,proto3" json:"destination_chain_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DepositData) Reset() {
+	*x = DepositData{}
+	mi := &file_plugin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositData) ProtoMessage() {}
+
+func (x *DepositData) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositData.ProtoReflect.Descriptor instead.
+func (*DepositData) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DepositData) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *DepositData) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *DepositData) GetDepositAmount() string {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return ""
+}
+
+func (x *DepositData) GetTokenAddress() string {
+	if x != nil {
+		return x.TokenAddress
+	}
+	return ""
+}
+
+func (x *DepositData) GetReferralId() uint32 {
+	if x != nil {
+		return x.ReferralId
+	}
+	return 0
+}
+
+func (x *DepositData) GetDestinationAddress() string {
+	if x != nil {
+		return x.DestinationAddress
+	}
+	return ""
+}
+
+func (x *DepositData) GetDestinationChainId() string {
+	if x != nil {
+		return x.DestinationChainId
+	}
+	return ""
+}
+
+type GetDepositDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*GetDepositDataResponse_Data
+	//	*GetDepositDataResponse_Error
+	Result        isGetDepositDataResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepositDataResponse) Reset() {
+	*x = GetDepositDataResponse{}
+	mi := &file_plugin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepositDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositDataResponse) ProtoMessage() {}
+
+func (x *GetDepositDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositDataResponse.ProtoReflect.Descriptor instead.
+func (*GetDepositDataResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDepositDataResponse) GetResult() isGetDepositDataResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetDepositDataResponse) GetData() *DepositData {
+	if x != nil {
+		if x, ok := x.Result.(*GetDepositDataResponse_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+func (x *GetDepositDataResponse) GetError() DepositError {
+	if x != nil {
+		if x, ok := x.Result.(*GetDepositDataResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return DepositError_DEPOSIT_ERROR_UNSPECIFIED
+}
+
+type isGetDepositDataResponse_Result interface {
+	isGetDepositDataResponse_Result()
+}
+
+type GetDepositDataResponse_Data struct {
+	Data *DepositData `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+
+type GetDepositDataResponse_Error struct {
+	// error is set if the deposit is pending or invalid,
+	// unexpected failures should be returned as the gRPC errors
+	Error DepositError `protobuf:"varint,2,opt,name=error,proto3,enum=plugin.DepositError,oneof"`
+}
+
+func (*GetDepositDataResponse_Data) isGetDepositDataResponse_Result() {}
+
+func (*GetDepositDataResponse_Error) isGetDepositDataResponse_Result() {}
+
+// Deposit is the deposit to be withdrawn to the adapter chain.
+type Deposit struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Identifier        *types.DepositIdentifier `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Depositor         *string                  `protobuf:"bytes,2,opt,name=depositor,proto3,oneof" json:"depositor,omitempty"`
+	DepositAmount     string                   `protobuf:"bytes,3,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	DepositToken      string                   `protobuf:"bytes,4,opt,name=deposit_token,json=depositToken,proto3" json:"deposit_token,omitempty"`
+	DepositBlock      uint64                   `protobuf:"varint,5,opt,name=deposit_block,json=depositBlock,proto3" json:"deposit_block,omitempty"`
+	ReferralId        uint32                   `protobuf:"varint,6,opt,name=referral_id,json=referralId,proto3" json:"referral_id,omitempty"`
+	Receiver          string                   `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	WithdrawalChainId string                   `protobuf:"bytes,8,opt,name=withdrawal_chain_id,json=withdrawalChainId,proto3" json:"withdrawal_chain_id,omitempty"`
+	WithdrawalToken   string                   `protobuf:"bytes,9,opt,name=withdrawal_token,json=withdrawalToken,proto3" json:"withdrawal_token,omitempty"`
+	WithdrawalAmount  string                   `protobuf:"bytes,10,opt,name=withdrawal_amount,json=withdrawalAmount,proto3" json:"withdrawal_amount,omitempty"`
+	CommissionAmount  string                   `protobuf:"bytes,11,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
+	IsWrappedToken    bool                     `protobuf:"varint,12,opt,name=is_wrapped_token,json=isWrappedToken,proto3" json:"is_wrapped_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	mi := &file_plugin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Deposit) GetIdentifier() *types.DepositIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *Deposit) GetDepositor() string {
+	if x != nil && x.Depositor != nil {
+		return *x.Depositor
+	}
+	return ""
+}
+
+func (x *Deposit) GetDepositAmount() string {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return ""
+}
+
+func (x *Deposit) GetDepositToken() string {
+	if x != nil {
+		return x.DepositToken
+	}
+	return ""
+}
+
+func (x *Deposit) GetDepositBlock() uint64 {
+	if x != nil {
+		return x.DepositBlock
+	}
+	return 0
+}
+
+func (x *Deposit) GetReferralId() uint32 {
+	if x != nil {
+		return x.ReferralId
+	}
+	return 0
+}
+
+func (x *Deposit) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *Deposit) GetWithdrawalChainId() string {
+	if x != nil {
+		return x.WithdrawalChainId
+	}
+	return ""
+}
+
+func (x *Deposit) GetWithdrawalToken() string {
+	if x != nil {
+		return x.WithdrawalToken
+	}
+	return ""
+}
+
+func (x *Deposit) GetWithdrawalAmount() string {
+	if x != nil {
+		return x.WithdrawalAmount
+	}
+	return ""
+}
+
+func (x *Deposit) GetCommissionAmount() string {
+	if x != nil {
+		return x.CommissionAmount
+	}
+	return ""
+}
+
+func (x *Deposit) GetIsWrappedToken() bool {
+	if x != nil {
+		return x.IsWrappedToken
+	}
+	return false
+}
+
+type FormSigningDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deposits available for the withdrawal, the adapter may include only a part of them
+	Deposits      []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormSigningDataRequest) Reset() {
+	*x = FormSigningDataRequest{}
+	mi := &file_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormSigningDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormSigningDataRequest) ProtoMessage() {}
+
+func (x *FormSigningDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormSigningDataRequest.ProtoReflect.Descriptor instead.
+func (*FormSigningDataRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FormSigningDataRequest) GetDeposits() []*Deposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+type SigningData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifiers of the deposits included into the withdrawal
+	DepositIds []*types.DepositIdentifier `protobuf:"bytes,1,rep,name=deposit_ids,json=depositIds,proto3" json:"deposit_ids,omitempty"`
+	// adapter-specific withdrawal data, f.e. the unsigned transaction
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// hashes signed by the TSS key of the chain curve, bounded by the signing batch size (5)
+	SigHashes     [][]byte `protobuf:"bytes,3,rep,name=sig_hashes,json=sigHashes,proto3" json:"sig_hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningData) Reset() {
+	*x = SigningData{}
+	mi := &file_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningData) ProtoMessage() {}
+
+func (x *SigningData) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningData.ProtoReflect.Descriptor instead.
+func (*SigningData) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SigningData) GetDepositIds() []*types.DepositIdentifier {
+	if x != nil {
+		return x.DepositIds
+	}
+	return nil
+}
+
+func (x *SigningData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SigningData) GetSigHashes() [][]byte {
+	if x != nil {
+		return x.SigHashes
+	}
+	return nil
+}
+
+type ValidateSigningDataRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SigningData *SigningData           `protobuf:"bytes,1,opt,name=signing_data,json=signingData,proto3" json:"signing_data,omitempty"`
+	// deposits in the order of the proposed identifiers
+	Deposits      []*Deposit `protobuf:"bytes,2,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateSigningDataRequest) Reset() {
+	*x = ValidateSigningDataRequest{}
+	mi := &file_plugin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateSigningDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateSigningDataRequest) ProtoMessage() {}
+
+func (x *ValidateSigningDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateSigningDataRequest.ProtoReflect.Descriptor instead.
+func (*ValidateSigningDataRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateSigningDataRequest) GetSigningData() *SigningData {
+	if x != nil {
+		return x.SigningData
+	}
+	return nil
+}
+
+func (x *ValidateSigningDataRequest) GetDeposits() []*Deposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+type Signature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// R || S for secp256k1, R || s for ed25519
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	// secp256k1 public key recovery byte
+	Recovery      []byte `protobuf:"bytes,2,opt,name=recovery,proto3" json:"recovery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	mi := &file_plugin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Signature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Signature) GetRecovery() []byte {
+	if x != nil {
+		return x.Recovery
+	}
+	return nil
+}
+
+type FinalizeRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SigningData *SigningData           `protobuf:"bytes,1,opt,name=signing_data,json=signingData,proto3" json:"signing_data,omitempty"`
+	// signatures in the order of the signed hashes
+	Signatures []*Signature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// leader is set for the session leader expected to submit the withdrawal
+	Leader        bool `protobuf:"varint,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeRequest) Reset() {
+	*x = FinalizeRequest{}
+	mi := &file_plugin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeRequest) ProtoMessage() {}
+
+func (x *FinalizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FinalizeRequest) GetSigningData() *SigningData {
+	if x != nil {
+		return x.SigningData
+	}
+	return nil
+}
+
+func (x *FinalizeRequest) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *FinalizeRequest) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+type ProcessedDeposit struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	Identifier       *types.DepositIdentifier `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Signature        *string                  `protobuf:"bytes,2,opt,name=signature,proto3,oneof" json:"signature,omitempty"`
+	WithdrawalTxHash *string                  `protobuf:"bytes,3,opt,name=withdrawal_tx_hash,json=withdrawalTxHash,proto3,oneof" json:"withdrawal_tx_hash,omitempty"`
+	TxData           *string                  `protobuf:"bytes,4,opt,name=tx_data,json=txData,proto3,oneof" json:"tx_data,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProcessedDeposit) Reset() {
+	*x = ProcessedDeposit{}
+	mi := &file_plugin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessedDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessedDeposit) ProtoMessage() {}
+
+func (x *ProcessedDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessedDeposit.ProtoReflect.Descriptor instead.
+func (*ProcessedDeposit) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProcessedDeposit) GetIdentifier() *types.DepositIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *ProcessedDeposit) GetSignature() string {
+	if x != nil && x.Signature != nil {
+		return *x.Signature
+	}
+	return ""
+}
+
+func (x *ProcessedDeposit) GetWithdrawalTxHash() string {
+	if x != nil && x.WithdrawalTxHash != nil {
+		return *x.WithdrawalTxHash
+	}
+	return ""
+}
+
+func (x *ProcessedDeposit) GetTxData() string {
+	if x != nil && x.TxData != nil {
+		return *x.TxData
+	}
+	return ""
+}
+
+type FinalizeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// withdrawal details stored for each of the deposits
+	Deposits      []*ProcessedDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeResponse) Reset() {
+	*x = FinalizeResponse{}
+	mi := &file_plugin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeResponse) ProtoMessage() {}
+
+func (x *FinalizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FinalizeResponse) GetDeposits() []*ProcessedDeposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+var File_plugin_proto protoreflect.FileDescriptor
+
+const file_plugin_proto_rawDesc = "" +
+	"\n" +
+	"\fplugin.proto\x12\x06plugin\x1a\x1bgoogle/protobuf/empty.proto\x1a\rdeposit.proto\"$\n" +
+	"\fValueRequest\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"@\n" +
+	"\x10ValidityResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x9a\x02\n" +
+	"\vDepositData\x12\x14\n" +
+	"\x05block\x18\x01 \x01(\x03R\x05block\x12%\n" +
+	"\x0esource_address\x18\x02 \x01(\tR\rsourceAddress\x12%\n" +
+	"\x0edeposit_amount\x18\x03 \x01(\tR\rdepositAmount\x12#\n" +
+	"\rtoken_address\x18\x04 \x01(\tR\ftokenAddress\x12\x1f\n" +
+	"\vreferral_id\x18\x05 \x01(\rR\n" +
+	"referralId\x12/\n" +
+	"\x13destination_address\x18\x06 \x01(\tR\x12destinationAddress\x120\n" +
+	"\x14destination_chain_id\x18\a \x01(\tR\x12destinationChainId\"{\n" +
+	"\x16GetDepositDataResponse\x12)\n" +
+	"\x04data\x18\x01 \x01(\v2\x13.plugin.DepositDataH\x00R\x04data\x12,\n" +
+	"\x05error\x18\x02 \x01(\x0e2\x14.plugin.DepositErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"\x83\x04\n" +
+	"\aDeposit\x12:\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierR\n" +
+	"identifier\x12!\n" +
+	"\tdepositor\x18\x02 \x01(\tH\x00R\tdepositor\x88\x01\x01\x12%\n" +
+	"\x0edeposit_amount\x18\x03 \x01(\tR\rdepositAmount\x12#\n" +
+	"\rdeposit_token\x18\x04 \x01(\tR\fdepositToken\x12#\n" +
+	"\rdeposit_block\x18\x05 \x01(\x04R\fdepositBlock\x12\x1f\n" +
+	"\vreferral_id\x18\x06 \x01(\rR\n" +
+	"referralId\x12\x1a\n" +
+	"\breceiver\x18\a \x01(\tR\breceiver\x12.\n" +
+	"\x13withdrawal_chain_id\x18\b \x01(\tR\x11withdrawalChainId\x12)\n" +
+	"\x10withdrawal_token\x18\t \x01(\tR\x0fwithdrawalToken\x12+\n" +
+	"\x11withdrawal_amount\x18\n" +
+	" \x01(\tR\x10withdrawalAmount\x12+\n" +
+	"\x11commission_amount\x18\v \x01(\tR\x10commissionAmount\x12(\n" +
+	"\x10is_wrapped_token\x18\f \x01(\bR\x0eisWrappedTokenB\f\n" +
+	"\n" +
+	"_depositor\"E\n" +
+	"\x16FormSigningDataRequest\x12+\n" +
+	"\bdeposits\x18\x01 \x03(\v2\x0f.plugin.DepositR\bdeposits\"}\n" +
+	"\vSigningData\x12;\n" +
+	"\vdeposit_ids\x18\x01 \x03(\v2\x1a.deposit.DepositIdentifierR\n" +
+	"depositIds\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1d\n" +
+	"\n" +
+	"sig_hashes\x18\x03 \x03(\fR\tsigHashes\"\x81\x01\n" +
+	"\x1aValidateSigningDataRequest\x126\n" +
+	"\fsigning_data\x18\x01 \x01(\v2\x13.plugin.SigningDataR\vsigningData\x12+\n" +
+	"\bdeposits\x18\x02 \x03(\v2\x0f.plugin.DepositR\bdeposits\"E\n" +
+	"\tSignature\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature\x12\x1a\n" +
+	"\brecovery\x18\x02 \x01(\fR\brecovery\"\x94\x01\n" +
+	"\x0fFinalizeRequest\x126\n" +
+	"\fsigning_data\x18\x01 \x01(\v2\x13.plugin.SigningDataR\vsigningData\x121\n" +
+	"\n" +
+	"signatures\x18\x02 \x03(\v2\x11.plugin.SignatureR\n" +
+	"signatures\x12\x16\n" +
+	"\x06leader\x18\x03 \x01(\bR\x06leader\"\xf3\x01\n" +
+	"\x10ProcessedDeposit\x12:\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\v2\x1a.deposit.DepositIdentifierR\n" +
+	"identifier\x12!\n" +
+	"\tsignature\x18\x02 \x01(\tH\x00R\tsignature\x88\x01\x01\x121\n" +
+	"\x12withdrawal_tx_hash\x18\x03 \x01(\tH\x01R\x10withdrawalTxHash\x88\x01\x01\x12\x1c\n" +
+	"\atx_data\x18\x04 \x01(\tH\x02R\x06txData\x88\x01\x01B\f\n" +
+	"\n" +
+	"_signatureB\x15\n" +
+	"\x13_withdrawal_tx_hashB\n" +
+	"\n" +
+	"\b_tx_data\"H\n" +
+	"\x10FinalizeResponse\x124\n" +
+	"\bdeposits\x18\x01 \x03(\v2\x18.plugin.ProcessedDepositR\bdeposits*\xf6\x02\n" +
+	"\fDepositError\x12\x1d\n" +
+	"\x19DEPOSIT_ERROR_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DEPOSIT_ERROR_TX_PENDING\x10\x01\x12\"\n" +
+	"\x1eDEPOSIT_ERROR_TX_NOT_CONFIRMED\x10\x02\x12\x1b\n" +
+	"\x17DEPOSIT_ERROR_TX_FAILED\x10\x03\x12\x1e\n" +
+	"\x1aDEPOSIT_ERROR_TX_NOT_FOUND\x10\x04\x12#\n" +
+	"\x1fDEPOSIT_ERROR_DEPOSIT_NOT_FOUND\x10\x05\x12*\n" +
+	"&DEPOSIT_ERROR_INVALID_RECEIVER_ADDRESS\x10\x06\x12*\n" +
+	"&DEPOSIT_ERROR_INVALID_DEPOSITED_AMOUNT\x10\a\x12#\n" +
+	"\x1fDEPOSIT_ERROR_UNSUPPORTED_EVENT\x10\b\x12&\n" +
+	"\"DEPOSIT_ERROR_UNSUPPORTED_CONTRACT\x10\t2\xd8\x04\n" +
+	"\fChainAdapter\x12N\n" +
+	"\x0eGetDepositData\x12\x1a.deposit.DepositIdentifier\x1a\x1e.plugin.GetDepositDataResponse\"\x00\x12@\n" +
+	"\fAddressValid\x12\x14.plugin.ValueRequest\x1a\x18.plugin.ValidityResponse\"\x00\x12H\n" +
+	"\x14TransactionHashValid\x12\x14.plugin.ValueRequest\x1a\x18.plugin.ValidityResponse\"\x00\x12I\n" +
+	"\x15WithdrawalAmountValid\x12\x14.plugin.ValueRequest\x1a\x18.plugin.ValidityResponse\"\x00\x12?\n" +
+	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12H\n" +
+	"\x0fFormSigningData\x12\x1e.plugin.FormSigningDataRequest\x1a\x13.plugin.SigningData\"\x00\x12U\n" +
+	"\x13ValidateSigningData\x12\".plugin.ValidateSigningDataRequest\x1a\x18.plugin.ValidityResponse\"\x00\x12?\n" +
+	"\bFinalize\x12\x17.plugin.FinalizeRequest\x1a\x18.plugin.FinalizeResponse\"\x00B2Z0github.com/Bridgeless-Project/tss-svc/pkg/pluginb\x06proto3"
+
+var (
+	file_plugin_proto_rawDescOnce sync.Once
+	file_plugin_proto_rawDescData []byte
+)
+
+func file_plugin_proto_rawDescGZIP() []byte {
+	file_plugin_proto_rawDescOnce.Do(func() {
+		file_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugin_proto_rawDesc), len(file_plugin_proto_rawDesc)))
+	})
+	return file_plugin_proto_rawDescData
+}
+
+var file_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_plugin_proto_goTypes = []any{
+	(DepositError)(0),                  // 0: plugin.DepositError
+	(*ValueRequest)(nil),               // 1: plugin.ValueRequest
+	(*ValidityResponse)(nil),           // 2: plugin.ValidityResponse
+	(*DepositData)(nil),                // 3: plugin.DepositData
+	(*GetDepositDataResponse)(nil),     // 4: plugin.GetDepositDataResponse
+	(*Deposit)(nil),                    // 5: plugin.Deposit
+	(*FormSigningDataRequest)(nil),     // 6: plugin.FormSigningDataRequest
+	(*SigningData)(nil),                // 7: plugin.SigningData
+	(*ValidateSigningDataRequest)(nil), // 8: plugin.ValidateSigningDataRequest
+	(*Signature)(nil),                  // 9: plugin.Signature
+	(*FinalizeRequest)(nil),            // 10: plugin.FinalizeRequest
+	(*ProcessedDeposit)(nil),           // 11: plugin.ProcessedDeposit
+	(*FinalizeResponse)(nil),           // 12: plugin.FinalizeResponse
+	(*types.DepositIdentifier)(nil),    // 13: deposit.DepositIdentifier
+	(*emptypb.Empty)(nil),              // 14: google.protobuf.Empty
+}
+var file_plugin_proto_depIdxs = []int32{
+	3,  // 0: plugin.GetDepositDataResponse.data:type_name -> plugin.DepositData
+	0,  // 1: plugin.GetDepositDataResponse.error:type_name -> plugin.DepositError
+	13, // 2: plugin.Deposit.identifier:type_name -> deposit.DepositIdentifier
+	5,  // 3: plugin.FormSigningDataRequest.deposits:type_name -> plugin.Deposit
+	13, // 4: plugin.SigningData.deposit_ids:type_name -> deposit.DepositIdentifier
+	7,  // 5: plugin.ValidateSigningDataRequest.signing_data:type_name -> plugin.SigningData
+	5,  // 6: plugin.ValidateSigningDataRequest.deposits:type_name -> plugin.Deposit
+	7,  // 7: plugin.FinalizeRequest.signing_data:type_name -> plugin.SigningData
+	9,  // 8: plugin.FinalizeRequest.signatures:type_name -> plugin.Signature
+	13, // 9: plugin.ProcessedDeposit.identifier:type_name -> deposit.DepositIdentifier
+	11, // 10: plugin.FinalizeResponse.deposits:type_name -> plugin.ProcessedDeposit
+	13, // 11: plugin.ChainAdapter.GetDepositData:input_type -> deposit.DepositIdentifier
+	1,  // 12: plugin.ChainAdapter.AddressValid:input_type -> plugin.ValueRequest
+	1,  // 13: plugin.ChainAdapter.TransactionHashValid:input_type -> plugin.ValueRequest
+	1,  // 14: plugin.ChainAdapter.WithdrawalAmountValid:input_type -> plugin.ValueRequest
+	14, // 15: plugin.ChainAdapter.HealthCheck:input_type -> google.protobuf.Empty
+	6,  // 16: plugin.ChainAdapter.FormSigningData:input_type -> plugin.FormSigningDataRequest
+	8,  // 17: plugin.ChainAdapter.ValidateSigningData:input_type -> plugin.ValidateSigningDataRequest
+	10, // 18: plugin.ChainAdapter.Finalize:input_type -> plugin.FinalizeRequest
+	4,  // 19: plugin.ChainAdapter.GetDepositData:output_type -> plugin.GetDepositDataResponse
+	2,  // 20: plugin.ChainAdapter.AddressValid:output_type -> plugin.ValidityResponse
+	2,  // 21: plugin.ChainAdapter.TransactionHashValid:output_type -> plugin.ValidityResponse
+	2,  // 22: plugin.ChainAdapter.WithdrawalAmountValid:output_type -> plugin.ValidityResponse
+	14, // 23: plugin.ChainAdapter.HealthCheck:output_type -> google.protobuf.Empty
+	7,  // 24: plugin.ChainAdapter.FormSigningData:output_type -> plugin.SigningData
+	2,  // 25: plugin.ChainAdapter.ValidateSigningData:output_type -> plugin.ValidityResponse
+	12, // 26: plugin.ChainAdapter.Finalize:output_type -> plugin.FinalizeResponse
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_plugin_proto_init() }
+func file_plugin_proto_init() {
+	if File_plugin_proto != nil {
+		return
+	}
+	file_plugin_proto_msgTypes[3].OneofWrappers = []any{
+		(*GetDepositDataResponse_Data)(nil),
+		(*GetDepositDataResponse_Error)(nil),
+	}
+	file_plugin_proto_msgTypes[4].OneofWrappers = []any{}
+	file_plugin_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugin_proto_rawDesc), len(file_plugin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_plugin_proto_goTypes,
+		DependencyIndexes: file_plugin_proto_depIdxs,
+		EnumInfos:         file_plugin_proto_enumTypes,
+		MessageInfos:      file_plugin_proto_msgTypes,
+	}.Build()
+	File_plugin_proto = out.File
+	file_plugin_proto_goTypes = nil
+	file_plugin_proto_depIdxs = nil
+}
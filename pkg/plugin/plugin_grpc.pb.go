@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: plugin.proto
+
+package plugin
+
+import (
+	context "context"
+	types "github.com/Bridgeless-Project/tss-svc/internal/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ChainAdapter_GetDepositData_FullMethodName        = "/plugin.ChainAdapter/GetDepositData"
+	ChainAdapter_AddressValid_FullMethodName          = "/plugin.ChainAdapter/AddressValid"
+	ChainAdapter_TransactionHashValid_FullMethodName  = "/plugin.ChainAdapter/TransactionHashValid"
+	ChainAdapter_WithdrawalAmountValid_FullMethodName = "/plugin.ChainAdapter/WithdrawalAmountValid"
+	ChainAdapter_HealthCheck_FullMethodName           = "/plugin.ChainAdapter/HealthCheck"
+	ChainAdapter_FormSigningData_FullMethodName       = "/plugin.ChainAdapter/FormSigningData"
+	ChainAdapter_ValidateSigningData_FullMethodName   = "/plugin.ChainAdapter/ValidateSigningData"
+	ChainAdapter_Finalize_FullMethodName              = "/plugin.ChainAdapter/Finalize"
+)
+
+// ChainAdapterClient is the client API for ChainAdapter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ChainAdapter is served by the external process integrating the chain of the `plugin` type.
+// Every TSS party runs its own adapter instance, so the responses must be deterministic
+// for the same chain state to let the parties reach the consensus.
+type ChainAdapterClient interface {
+	// GetDepositData returns the deposit data of the source chain transaction, mirrors chain.Client.
+	GetDepositData(ctx context.Context, in *types.DepositIdentifier, opts ...grpc.CallOption) (*GetDepositDataResponse, error)
+	AddressValid(ctx context.Context, in *ValueRequest, opts ...grpc.CallOption) (*ValidityResponse, error)
+	TransactionHashValid(ctx context.Context, in *ValueRequest, opts ...grpc.CallOption) (*ValidityResponse, error)
+	// WithdrawalAmountValid checks the decimal withdrawal amount.
+	WithdrawalAmountValid(ctx context.Context, in *ValueRequest, opts ...grpc.CallOption) (*ValidityResponse, error)
+	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// FormSigningData forms the withdrawal of the deposits proposed by the session leader.
+	// Empty signing data skips the session.
+	FormSigningData(ctx context.Context, in *FormSigningDataRequest, opts ...grpc.CallOption) (*SigningData, error)
+	// ValidateSigningData checks the withdrawal proposed by another party.
+	ValidateSigningData(ctx context.Context, in *ValidateSigningDataRequest, opts ...grpc.CallOption) (*ValidityResponse, error)
+	// Finalize builds the signed withdrawal, the session leader is expected to submit it to the chain.
+	Finalize(ctx context.Context, in *FinalizeRequest, opts ...grpc.CallOption) (*FinalizeResponse, error)
+}
+
+type chainAdapterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChainAdapterClient(cc grpc.ClientConnInterface) ChainAdapterClient {
+	return &chainAdapterClient{cc}
+}
+
+func (c *chainAdapterClient) GetDepositData(ctx context.Context, in *types.DepositIdentifier, opts ...grpc.CallOption) (*GetDepositDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDepositDataResponse)
+	err := c.cc.Invoke(ctx, ChainAdapter_GetDepositData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainAdapterClient) AddressValid(ctx context.Context, in *ValueRequest, opts ...grpc.CallOption) (*ValidityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidityResponse)
+	err := c.cc.Invoke(ctx, ChainAdapter_AddressValid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainAdapterClient) TransactionHashValid(ctx context.Context, in *ValueRequest, opts ...grpc.CallOption) (*ValidityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidityResponse)
+	err := c.cc.Invoke(ctx, ChainAdapter_TransactionHashValid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainAdapterClient) WithdrawalAmountValid(ctx context.Context, in *ValueRequest, opts ...grpc.CallOption) (*ValidityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidityResponse)
+	err := c.cc.Invoke(ctx, ChainAdapter_WithdrawalAmountValid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainAdapterClient) HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChainAdapter_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainAdapterClient) FormSigningData(ctx context.Context, in *FormSigningDataRequest, opts ...grpc.CallOption) (*SigningData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SigningData)
+	err := c.cc.Invoke(ctx, ChainAdapter_FormSigningData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainAdapterClient) ValidateSigningData(ctx context.Context, in *ValidateSigningDataRequest, opts ...grpc.CallOption) (*ValidityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidityResponse)
+	err := c.cc.Invoke(ctx, ChainAdapter_ValidateSigningData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainAdapterClient) Finalize(ctx context.Context, in *FinalizeRequest, opts ...grpc.CallOption) (*FinalizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalizeResponse)
+	err := c.cc.Invoke(ctx, ChainAdapter_Finalize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChainAdapterServer is the server API for ChainAdapter service.
+// All implementations should embed UnimplementedChainAdapterServer
+// for forward compatibility.
+//
+// ChainAdapter is served by the external process integrating the chain of the `plugin` type.
+// Every TSS party runs its own adapter instance, so the responses must be deterministic
+// for the same chain state to let the parties reach the consensus.
+type ChainAdapterServer interface {
+	// GetDepositData returns the deposit data of the source chain transaction, mirrors chain.Client.
+	GetDepositData(context.Context, *types.DepositIdentifier) (*GetDepositDataResponse, error)
+	AddressValid(context.Context, *ValueRequest) (*ValidityResponse, error)
+	TransactionHashValid(context.Context, *ValueRequest) (*ValidityResponse, error)
+	// WithdrawalAmountValid checks the decimal withdrawal amount.
+	WithdrawalAmountValid(context.Context, *ValueRequest) (*ValidityResponse, error)
+	HealthCheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// FormSigningData forms the withdrawal of the deposits proposed by the session leader.
+	// Empty signing data skips the session.
+	FormSigningData(context.Context, *FormSigningDataRequest) (*SigningData, error)
+	// ValidateSigningData checks the withdrawal proposed by another party.
+	ValidateSigningData(context.Context, *ValidateSigningDataRequest) (*ValidityResponse, error)
+	// Finalize builds the signed withdrawal, the session leader is expected to submit it to the chain.
+	Finalize(context.Context, *FinalizeRequest) (*FinalizeResponse, error)
+}
+
+// UnimplementedChainAdapterServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChainAdapterServer struct{}
+
+func (UnimplementedChainAdapterServer) GetDepositData(context.Context, *types.DepositIdentifier) (*GetDepositDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositData not implemented")
+}
+func (UnimplementedChainAdapterServer) AddressValid(context.Context, *ValueRequest) (*ValidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressValid not implemented")
+}
+func (UnimplementedChainAdapterServer) TransactionHashValid(context.Context, *ValueRequest) (*ValidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionHashValid not implemented")
+}
+func (UnimplementedChainAdapterServer) WithdrawalAmountValid(context.Context, *ValueRequest) (*ValidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalAmountValid not implemented")
+}
+func (UnimplementedChainAdapterServer) HealthCheck(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedChainAdapterServer) FormSigningData(context.Context, *FormSigningDataRequest) (*SigningData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormSigningData not implemented")
+}
+func (UnimplementedChainAdapterServer) ValidateSigningData(context.Context, *ValidateSigningDataRequest) (*ValidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSigningData not implemented")
+}
+func (UnimplementedChainAdapterServer) Finalize(context.Context, *FinalizeRequest) (*FinalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Finalize not implemented")
+}
+func (UnimplementedChainAdapterServer) testEmbeddedByValue() {}
+
+// UnsafeChainAdapterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChainAdapterServer will
+// result in compilation errors.
+type UnsafeChainAdapterServer interface {
+	mustEmbedUnimplementedChainAdapterServer()
+}
+
+func RegisterChainAdapterServer(s grpc.ServiceRegistrar, srv ChainAdapterServer) {
+	// If the following call pancis, it indicates UnimplementedChainAdapterServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChainAdapter_ServiceDesc, srv)
+}
+
+func _ChainAdapter_GetDepositData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.DepositIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).GetDepositData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_GetDepositData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).GetDepositData(ctx, req.(*types.DepositIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainAdapter_AddressValid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).AddressValid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_AddressValid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).AddressValid(ctx, req.(*ValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainAdapter_TransactionHashValid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).TransactionHashValid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_TransactionHashValid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).TransactionHashValid(ctx, req.(*ValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainAdapter_WithdrawalAmountValid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).WithdrawalAmountValid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_WithdrawalAmountValid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).WithdrawalAmountValid(ctx, req.(*ValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainAdapter_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).HealthCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainAdapter_FormSigningData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormSigningDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).FormSigningData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_FormSigningData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).FormSigningData(ctx, req.(*FormSigningDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainAdapter_ValidateSigningData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSigningDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).ValidateSigningData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_ValidateSigningData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).ValidateSigningData(ctx, req.(*ValidateSigningDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainAdapter_Finalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainAdapterServer).Finalize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChainAdapter_Finalize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainAdapterServer).Finalize(ctx, req.(*FinalizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChainAdapter_ServiceDesc is the grpc.ServiceDesc for ChainAdapter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChainAdapter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "plugin.ChainAdapter",
+	HandlerType: (*ChainAdapterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDepositData",
+			Handler:    _ChainAdapter_GetDepositData_Handler,
+		},
+		{
+			MethodName: "AddressValid",
+			Handler:    _ChainAdapter_AddressValid_Handler,
+		},
+		{
+			MethodName: "TransactionHashValid",
+			Handler:    _ChainAdapter_TransactionHashValid_Handler,
+		},
+		{
+			MethodName: "WithdrawalAmountValid",
+			Handler:    _ChainAdapter_WithdrawalAmountValid_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _ChainAdapter_HealthCheck_Handler,
+		},
+		{
+			MethodName: "FormSigningData",
+			Handler:    _ChainAdapter_FormSigningData_Handler,
+		},
+		{
+			MethodName: "ValidateSigningData",
+			Handler:    _ChainAdapter_ValidateSigningData_Handler,
+		},
+		{
+			MethodName: "Finalize",
+			Handler:    _ChainAdapter_Finalize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "plugin.proto",
+}